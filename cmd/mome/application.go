@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"sync"
+	"time"
 
 	pb "github.com/karta0898098/mome/pb/order"
 	"github.com/karta0898098/mome/pkg/configs"
@@ -20,6 +21,9 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 )
 
+// gracefulStopTimeout is how long the grpc server waits for running calls to finish
+const gracefulStopTimeout = 10 * time.Second
+
 // Application contains this app need components
 type Application struct {
 	cfg      configs.ConfigurationProvider // Cfg is configuration provider. It provide all this application server need config.
@@ -31,9 +35,21 @@ type Application struct {
 func NewApplication(cfg configs.ConfigurationProvider, logger zerolog.Logger) *Application {
 	repo := new(order.NopRepository)
 	orderBooksFactory := &order.BooksFactory{Mode: "demo", OrderRepo: repo}
-	orderBooks := orderBooksFactory.Create()
+	if instruments := cfg.Get().Instruments; len(instruments) > 0 {
+		books, err := newBookConfigs(instruments)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to load instruments")
+		}
+		orderBooksFactory.Mode = "config"
+		orderBooksFactory.Books = books
+	}
+	orderBooks, err := orderBooksFactory.Create()
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to create order books")
+	}
 
-	provider := service.NewOrderProviderImpl(orderBooks, repo)
+	provider := service.NewOrderProviderImpl(orderBooks, repo,
+		service.WithClientOrderIDWindow(cfg.Get().Order.ClientOrderIDWindow))
 
 	return &Application{
 		cfg:      cfg,
@@ -90,7 +106,17 @@ func (app *Application) startGRPCServer(ctx context.Context, wg *sync.WaitGroup)
 
 	<-ctx.Done()
 
-	server.GracefulStop()
+	// order entry sessions are long-lived streams, stop them once they didn't finish in time
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(gracefulStopTimeout):
+		server.Stop()
+	}
 
 	app.logger.Info().Msgf("grpc server gracefully stopped")
 }
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/apd"
+
+	"github.com/karta0898098/mome/pkg/configs"
+	"github.com/karta0898098/mome/pkg/order"
+)
+
+// newBookConfigs converts the instruments config to the order books created in config mode
+func newBookConfigs(instruments []configs.Instrument) ([]order.BookConfig, error) {
+	books := make([]order.BookConfig, 0, len(instruments))
+	for _, cfg := range instruments {
+		book := order.BookConfig{Symbol: cfg.Symbol}
+		var err error
+		if book.MarketPrice, err = parseDecimal(cfg.MarketPrice); err != nil {
+			return nil, fmt.Errorf("instrument %s market price %w", cfg.Symbol, err)
+		}
+		if book.ReferencePrice, err = parseDecimal(cfg.ReferencePrice); err != nil {
+			return nil, fmt.Errorf("instrument %s reference price %w", cfg.Symbol, err)
+		}
+		if book.Instrument, err = newInstrument(cfg); err != nil {
+			return nil, fmt.Errorf("instrument %s %w", cfg.Symbol, err)
+		}
+		if book.Schedule, err = newSchedule(cfg.Schedule); err != nil {
+			return nil, fmt.Errorf("instrument %s schedule %w", cfg.Symbol, err)
+		}
+		book.Algorithm, err = order.ParseMatchingAlgorithm(cfg.Matching.Algorithm, cfg.Matching.TopOrder, cfg.Matching.MinAllocation, order.LMM{
+			Customers: cfg.Matching.LMMCustomers,
+			Percent:   cfg.Matching.LMMPercent,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("instrument %s %w", cfg.Symbol, err)
+		}
+		books = append(books, book)
+	}
+	return books, nil
+}
+
+// newInstrument converts the instrument config, the order books factory validates it
+func newInstrument(cfg configs.Instrument) (order.Instrument, error) {
+	var (
+		instrument = order.Instrument{
+			LotSize:     cfg.LotSize,
+			MinQty:      cfg.MinQty,
+			MaxQty:      cfg.MaxQty,
+			CollarTicks: cfg.CollarTicks,
+		}
+		err error
+	)
+	if instrument.TickSize, err = parseDecimal(cfg.TickSize); err != nil {
+		return instrument, fmt.Errorf("tick size %w", err)
+	}
+	if instrument.MinNotional, err = parseDecimal(cfg.MinNotional); err != nil {
+		return instrument, fmt.Errorf("min notional %w", err)
+	}
+	if instrument.MaxNotional, err = parseDecimal(cfg.MaxNotional); err != nil {
+		return instrument, fmt.Errorf("max notional %w", err)
+	}
+	if instrument.PriceBandPercent, err = parseDecimal(cfg.PriceBandPercent); err != nil {
+		return instrument, fmt.Errorf("price band percent %w", err)
+	}
+	if instrument.PriceBandPolicy, err = order.ParseBandPolicy(cfg.PriceBandPolicy); err != nil {
+		return instrument, err
+	}
+	if instrument.MarketPolicy, err = order.ParseMarketPolicy(cfg.MarketPolicy); err != nil {
+		return instrument, err
+	}
+	if instrument.Fees, err = newFeeSchedule(cfg.Fees); err != nil {
+		return instrument, err
+	}
+
+	// the steps have to ascend by their bounds, a step at or below the bound of the previous one overlaps it
+	for k, step := range cfg.TickLadder {
+		var level order.TickLevel
+		if level.Below, err = parseDecimal(step.Below); err != nil {
+			return instrument, fmt.Errorf("tick ladder bound %w", err)
+		}
+		if level.TickSize, err = parseDecimal(step.TickSize); err != nil {
+			return instrument, fmt.Errorf("tick ladder tick size %w", err)
+		}
+		if level.Below.Sign() == 0 || level.TickSize.Sign() == 0 {
+			return instrument, fmt.Errorf("tick ladder bound %s tick size %s %w", step.Below, step.TickSize, order.ErrInvalidInstrument)
+		}
+		if k > 0 && instrument.TickLadder[k-1].Below.Cmp(&level.Below) >= 0 {
+			return instrument, fmt.Errorf("tick ladder bound %s doesn't ascend %w", step.Below, order.ErrInvalidInstrument)
+		}
+		instrument.TickLadder = append(instrument.TickLadder, level)
+	}
+
+	return instrument, nil
+}
+
+// newSchedule converts the schedule config, an empty config has no schedule
+func newSchedule(cfg configs.Schedule) (order.Schedule, error) {
+	var schedule order.Schedule
+	if cfg == (configs.Schedule{}) {
+		return schedule, nil
+	}
+
+	times := []struct {
+		value string
+		start *time.Duration
+	}{
+		{value: cfg.PreOpen, start: &schedule.PreOpen},
+		{value: cfg.Continuous, start: &schedule.Continuous},
+		{value: cfg.Closing, start: &schedule.Closing},
+		{value: cfg.Closed, start: &schedule.Closed},
+	}
+	for _, t := range times {
+		clock, err := time.Parse("15:04", t.value)
+		if err != nil {
+			return schedule, fmt.Errorf("time of day %s %w", t.value, order.ErrInvalidInstrument)
+		}
+		*t.start = time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute
+	}
+	return schedule, nil
+}
+
+// newFeeSchedule converts the fees config, the maker rates can be negative for rebates
+func newFeeSchedule(cfg configs.Fees) (order.FeeSchedule, error) {
+	var (
+		schedule order.FeeSchedule
+		err      error
+	)
+	if schedule.Maker, err = parseRate(cfg.Maker); err != nil {
+		return schedule, fmt.Errorf("maker fee %w", err)
+	}
+	if schedule.Taker, err = parseRate(cfg.Taker); err != nil {
+		return schedule, fmt.Errorf("taker fee %w", err)
+	}
+	if schedule.Scale, err = parseDecimal(cfg.Scale); err != nil {
+		return schedule, fmt.Errorf("fee scale %w", err)
+	}
+	for _, tier := range cfg.Tiers {
+		feeTier := order.FeeTier{Name: tier.Name, Customers: tier.Customers}
+		if feeTier.Maker, err = parseRate(tier.Maker); err != nil {
+			return schedule, fmt.Errorf("fee tier %s maker fee %w", tier.Name, err)
+		}
+		if feeTier.Taker, err = parseRate(tier.Taker); err != nil {
+			return schedule, fmt.Errorf("fee tier %s taker fee %w", tier.Name, err)
+		}
+		schedule.Tiers = append(schedule.Tiers, feeTier)
+	}
+	return schedule, nil
+}
+
+// parseRate parses a fee rate of the config which can be negative, an empty string is zero
+func parseRate(s string) (apd.Decimal, error) {
+	if s == "" {
+		return apd.Decimal{}, nil
+	}
+	d, _, err := apd.NewFromString(s)
+	if err != nil {
+		return apd.Decimal{}, fmt.Errorf("%s %w", s, order.ErrInvalidInstrument)
+	}
+	return *d, nil
+}
+
+// parseDecimal parses a decimal string of the config, an empty string is zero
+func parseDecimal(s string) (apd.Decimal, error) {
+	d, err := parseRate(s)
+	if err != nil {
+		return d, err
+	}
+	if d.Sign() < 0 {
+		return apd.Decimal{}, fmt.Errorf("%s is negative %w", s, order.ErrInvalidInstrument)
+	}
+	return d, nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/apd"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/karta0898098/mome/pkg/configs"
+	"github.com/karta0898098/mome/pkg/order"
+)
+
+func TestNewBookConfigs(t *testing.T) {
+	valid := func() configs.Instrument {
+		return configs.Instrument{
+			Symbol:      "TEST",
+			MarketPrice: "20.25",
+			TickSize:    "0.1",
+			TickLadder: []configs.TickLevel{
+				{Below: "10", TickSize: "0.01"},
+				{Below: "50", TickSize: "0.05"},
+			},
+			LotSize:          1,
+			MinQty:           1,
+			MaxQty:           1000,
+			PriceBandPercent: "10",
+			PriceBandPolicy:  "limit",
+			MarketPolicy:     "collar",
+			CollarTicks:      10,
+			Schedule:         configs.Schedule{PreOpen: "00:30", Continuous: "01:00", Closing: "07:50", Closed: "08:00"},
+			Matching:         configs.Matching{Algorithm: "pro-rata", MinAllocation: 2},
+			Fees:             configs.Fees{Maker: "-0.0001", Taker: "0.0005", Scale: "0.01"},
+		}
+	}
+
+	books, err := newBookConfigs([]configs.Instrument{valid()})
+	assert.NoError(t, err)
+	if assert.Len(t, books, 1) {
+		book := books[0]
+		assert.Equal(t, "TEST", book.Symbol)
+		assert.Equal(t, "20.25", book.MarketPrice.String())
+		assert.Len(t, book.Instrument.TickLadder, 2)
+		assert.Equal(t, "0.05", book.Instrument.TickLadder[1].TickSize.String())
+		assert.Equal(t, order.BandPolicyLimit, book.Instrument.PriceBandPolicy)
+		assert.Equal(t, order.MarketPolicyCollar, book.Instrument.MarketPolicy)
+		assert.Equal(t, 0, book.Instrument.Fees.Maker.Cmp(apd.New(-1, -4)))
+		assert.Equal(t, "0.01", book.Instrument.Fees.Scale.String())
+		assert.Equal(t, 30*time.Minute, book.Schedule.PreOpen)
+		assert.Equal(t, 8*time.Hour, book.Schedule.Closed)
+		assert.Equal(t, order.ProRata{MinAllocation: 2}, book.Algorithm)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(cfg *configs.Instrument)
+	}{
+		{
+			name: "unsorted tick ladder",
+			modify: func(cfg *configs.Instrument) {
+				cfg.TickLadder[0], cfg.TickLadder[1] = cfg.TickLadder[1], cfg.TickLadder[0]
+			},
+		},
+		{
+			name:   "overlapping tick ladder",
+			modify: func(cfg *configs.Instrument) { cfg.TickLadder[1].Below = "10" },
+		},
+		{
+			name:   "zero tick ladder tick size",
+			modify: func(cfg *configs.Instrument) { cfg.TickLadder[0].TickSize = "0" },
+		},
+		{
+			name:   "bad tick ladder bound",
+			modify: func(cfg *configs.Instrument) { cfg.TickLadder[0].Below = "ten" },
+		},
+		{
+			name:   "bad market price",
+			modify: func(cfg *configs.Instrument) { cfg.MarketPrice = "20,25" },
+		},
+		{
+			name:   "negative tick size",
+			modify: func(cfg *configs.Instrument) { cfg.TickSize = "-0.1" },
+		},
+		{
+			name:   "bad fee rate",
+			modify: func(cfg *configs.Instrument) { cfg.Fees.Taker = "5bp" },
+		},
+		{
+			name:   "negative fee scale",
+			modify: func(cfg *configs.Instrument) { cfg.Fees.Scale = "-0.01" },
+		},
+		{
+			name:   "bad schedule time",
+			modify: func(cfg *configs.Instrument) { cfg.Schedule.Closing = "7.50" },
+		},
+		{
+			name:   "unknown matching algorithm",
+			modify: func(cfg *configs.Instrument) { cfg.Matching.Algorithm = "lifo" },
+		},
+		{
+			name:   "unknown market policy",
+			modify: func(cfg *configs.Instrument) { cfg.MarketPolicy = "drop" },
+		},
+		{
+			name:   "unknown band policy",
+			modify: func(cfg *configs.Instrument) { cfg.PriceBandPolicy = "halt" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.modify(&cfg)
+			_, err := newBookConfigs([]configs.Instrument{cfg})
+			assert.ErrorIs(t, err, order.ErrInvalidInstrument)
+		})
+	}
+}
@@ -20,17 +20,20 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// OrderParams is enum of order params
 type OrderParams int32
 
 const (
-	OrderParams_ORDER_PARAMS_UNKNOWN OrderParams = 0
-	OrderParams_ORDER_PARAMS_STOP    OrderParams = 1 // stop order (has to have stop price set)
-	OrderParams_ORDER_PARAMS_AON     OrderParams = 2 // all-or-nothing - complete fill or cancel
-	OrderParams_ORDER_PARAMS_IOC     OrderParams = 3 // immediate-or-cancel - immediately fill what you can, cancel the rest
-	OrderParams_ORDER_PARAMS_FOK     OrderParams = 4 // immediately try to fill the whole order
-	OrderParams_ORDER_PARAMS_GTC     OrderParams = 5 // good-till-cancelled -  keep order active until manually cancelled
-	OrderParams_ORDER_PARAMS_GFD     OrderParams = 6 // good-for-day keep order active until the end of the trading day
-	OrderParams_ORDER_PARAMS_GTD     OrderParams = 7 // good-till-date - keep order active until the provided date (including the date)
+	OrderParams_ORDER_PARAMS_UNKNOWN       OrderParams = 0
+	OrderParams_ORDER_PARAMS_STOP          OrderParams = 1 // stop order (has to have stop price set)
+	OrderParams_ORDER_PARAMS_AON           OrderParams = 2 // all-or-nothing - complete fill or cancel
+	OrderParams_ORDER_PARAMS_IOC           OrderParams = 3 // immediate-or-cancel - immediately fill what you can, cancel the rest
+	OrderParams_ORDER_PARAMS_FOK           OrderParams = 4 // immediately try to fill the whole order
+	OrderParams_ORDER_PARAMS_GTC           OrderParams = 5 // good-till-cancelled -  keep order active until manually cancelled
+	OrderParams_ORDER_PARAMS_GFD           OrderParams = 6 // good-for-day keep order active until the end of the trading day
+	OrderParams_ORDER_PARAMS_GTD           OrderParams = 7 // good-till-date - keep order active until the provided date (including the date)
+	OrderParams_ORDER_PARAMS_TRAILING_STOP OrderParams = 8 // trailing stop order (has to have trail amount or trail percent set)
+	OrderParams_ORDER_PARAMS_POST_ONLY     OrderParams = 9 // post-only - the order never takes liquidity, it is rejected or repriced instead
 )
 
 // Enum value maps for OrderParams.
@@ -44,16 +47,20 @@ var (
 		5: "ORDER_PARAMS_GTC",
 		6: "ORDER_PARAMS_GFD",
 		7: "ORDER_PARAMS_GTD",
+		8: "ORDER_PARAMS_TRAILING_STOP",
+		9: "ORDER_PARAMS_POST_ONLY",
 	}
 	OrderParams_value = map[string]int32{
-		"ORDER_PARAMS_UNKNOWN": 0,
-		"ORDER_PARAMS_STOP":    1,
-		"ORDER_PARAMS_AON":     2,
-		"ORDER_PARAMS_IOC":     3,
-		"ORDER_PARAMS_FOK":     4,
-		"ORDER_PARAMS_GTC":     5,
-		"ORDER_PARAMS_GFD":     6,
-		"ORDER_PARAMS_GTD":     7,
+		"ORDER_PARAMS_UNKNOWN":       0,
+		"ORDER_PARAMS_STOP":          1,
+		"ORDER_PARAMS_AON":           2,
+		"ORDER_PARAMS_IOC":           3,
+		"ORDER_PARAMS_FOK":           4,
+		"ORDER_PARAMS_GTC":           5,
+		"ORDER_PARAMS_GFD":           6,
+		"ORDER_PARAMS_GTD":           7,
+		"ORDER_PARAMS_TRAILING_STOP": 8,
+		"ORDER_PARAMS_POST_ONLY":     9,
 	}
 )
 
@@ -84,6 +91,7 @@ func (OrderParams) EnumDescriptor() ([]byte, []int) {
 	return file_order_order_proto_rawDescGZIP(), []int{0}
 }
 
+// OrderKind is enum the order kind
 type OrderKind int32
 
 const (
@@ -133,6 +141,7 @@ func (OrderKind) EnumDescriptor() ([]byte, []int) {
 	return file_order_order_proto_rawDescGZIP(), []int{1}
 }
 
+// OrderSide is enum of the side
 type OrderSide int32
 
 const (
@@ -182,6 +191,222 @@ func (OrderSide) EnumDescriptor() ([]byte, []int) {
 	return file_order_order_proto_rawDescGZIP(), []int{2}
 }
 
+// OrderPeg is enum of the price a pegged order follows
+type OrderPeg int32
+
+const (
+	OrderPeg_ORDER_PEG_NONE     OrderPeg = 0
+	OrderPeg_ORDER_PEG_BEST_BID OrderPeg = 1
+	OrderPeg_ORDER_PEG_BEST_ASK OrderPeg = 2
+	OrderPeg_ORDER_PEG_MIDPOINT OrderPeg = 3
+)
+
+// Enum value maps for OrderPeg.
+var (
+	OrderPeg_name = map[int32]string{
+		0: "ORDER_PEG_NONE",
+		1: "ORDER_PEG_BEST_BID",
+		2: "ORDER_PEG_BEST_ASK",
+		3: "ORDER_PEG_MIDPOINT",
+	}
+	OrderPeg_value = map[string]int32{
+		"ORDER_PEG_NONE":     0,
+		"ORDER_PEG_BEST_BID": 1,
+		"ORDER_PEG_BEST_ASK": 2,
+		"ORDER_PEG_MIDPOINT": 3,
+	}
+)
+
+func (x OrderPeg) Enum() *OrderPeg {
+	p := new(OrderPeg)
+	*p = x
+	return p
+}
+
+func (x OrderPeg) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderPeg) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_proto_enumTypes[3].Descriptor()
+}
+
+func (OrderPeg) Type() protoreflect.EnumType {
+	return &file_order_order_proto_enumTypes[3]
+}
+
+func (x OrderPeg) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderPeg.Descriptor instead.
+func (OrderPeg) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{3}
+}
+
+// SelfTradePrevention is enum of the self-trade prevention modes
+type SelfTradePrevention int32
+
+const (
+	SelfTradePrevention_STP_NONE                 SelfTradePrevention = 0
+	SelfTradePrevention_STP_CANCEL_NEWEST        SelfTradePrevention = 1 // cancel the incoming order
+	SelfTradePrevention_STP_CANCEL_OLDEST        SelfTradePrevention = 2 // cancel the resting order
+	SelfTradePrevention_STP_CANCEL_BOTH          SelfTradePrevention = 3 // cancel both orders
+	SelfTradePrevention_STP_DECREMENT_AND_CANCEL SelfTradePrevention = 4 // cancel the smaller order and decrement the bigger one by its quantity
+)
+
+// Enum value maps for SelfTradePrevention.
+var (
+	SelfTradePrevention_name = map[int32]string{
+		0: "STP_NONE",
+		1: "STP_CANCEL_NEWEST",
+		2: "STP_CANCEL_OLDEST",
+		3: "STP_CANCEL_BOTH",
+		4: "STP_DECREMENT_AND_CANCEL",
+	}
+	SelfTradePrevention_value = map[string]int32{
+		"STP_NONE":                 0,
+		"STP_CANCEL_NEWEST":        1,
+		"STP_CANCEL_OLDEST":        2,
+		"STP_CANCEL_BOTH":          3,
+		"STP_DECREMENT_AND_CANCEL": 4,
+	}
+)
+
+func (x SelfTradePrevention) Enum() *SelfTradePrevention {
+	p := new(SelfTradePrevention)
+	*p = x
+	return p
+}
+
+func (x SelfTradePrevention) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SelfTradePrevention) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_proto_enumTypes[4].Descriptor()
+}
+
+func (SelfTradePrevention) Type() protoreflect.EnumType {
+	return &file_order_order_proto_enumTypes[4]
+}
+
+func (x SelfTradePrevention) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SelfTradePrevention.Descriptor instead.
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{4}
+}
+
+// TradingPhase is enum of the trading phases of an order book
+type TradingPhase int32
+
+const (
+	TradingPhase_TRADING_PHASE_UNSPECIFIED TradingPhase = 0
+	TradingPhase_TRADING_PHASE_CONTINUOUS  TradingPhase = 1 // orders are matched as they arrive
+	TradingPhase_TRADING_PHASE_PRE_OPEN    TradingPhase = 2 // orders are collected without matching before the open
+	TradingPhase_TRADING_PHASE_CLOSING     TradingPhase = 3 // orders are collected without matching before the close
+	TradingPhase_TRADING_PHASE_CLOSED      TradingPhase = 4 // orders are rejected
+)
+
+// Enum value maps for TradingPhase.
+var (
+	TradingPhase_name = map[int32]string{
+		0: "TRADING_PHASE_UNSPECIFIED",
+		1: "TRADING_PHASE_CONTINUOUS",
+		2: "TRADING_PHASE_PRE_OPEN",
+		3: "TRADING_PHASE_CLOSING",
+		4: "TRADING_PHASE_CLOSED",
+	}
+	TradingPhase_value = map[string]int32{
+		"TRADING_PHASE_UNSPECIFIED": 0,
+		"TRADING_PHASE_CONTINUOUS":  1,
+		"TRADING_PHASE_PRE_OPEN":    2,
+		"TRADING_PHASE_CLOSING":     3,
+		"TRADING_PHASE_CLOSED":      4,
+	}
+)
+
+func (x TradingPhase) Enum() *TradingPhase {
+	p := new(TradingPhase)
+	*p = x
+	return p
+}
+
+func (x TradingPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TradingPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_proto_enumTypes[5].Descriptor()
+}
+
+func (TradingPhase) Type() protoreflect.EnumType {
+	return &file_order_order_proto_enumTypes[5]
+}
+
+func (x TradingPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TradingPhase.Descriptor instead.
+func (TradingPhase) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{5}
+}
+
+// MarketRemainder is enum of what happened to the rest of a market order
+type MarketRemainder int32
+
+const (
+	MarketRemainder_MARKET_REMAINDER_NONE      MarketRemainder = 0 // the market order was filled or rests as a market order
+	MarketRemainder_MARKET_REMAINDER_CANCELLED MarketRemainder = 1 // the rest was cancelled
+	MarketRemainder_MARKET_REMAINDER_LIMITED   MarketRemainder = 2 // the rest rests as a limit order at the price of the reply
+)
+
+// Enum value maps for MarketRemainder.
+var (
+	MarketRemainder_name = map[int32]string{
+		0: "MARKET_REMAINDER_NONE",
+		1: "MARKET_REMAINDER_CANCELLED",
+		2: "MARKET_REMAINDER_LIMITED",
+	}
+	MarketRemainder_value = map[string]int32{
+		"MARKET_REMAINDER_NONE":      0,
+		"MARKET_REMAINDER_CANCELLED": 1,
+		"MARKET_REMAINDER_LIMITED":   2,
+	}
+)
+
+func (x MarketRemainder) Enum() *MarketRemainder {
+	p := new(MarketRemainder)
+	*p = x
+	return p
+}
+
+func (x MarketRemainder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketRemainder) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_proto_enumTypes[6].Descriptor()
+}
+
+func (MarketRemainder) Type() protoreflect.EnumType {
+	return &file_order_order_proto_enumTypes[6]
+}
+
+func (x MarketRemainder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketRemainder.Descriptor instead.
+func (MarketRemainder) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{6}
+}
+
+// Order define order entity
 type Order struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -195,6 +420,14 @@ type Order struct {
 	Quantity       int64       `protobuf:"varint,6,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
 	FilledQuantity int64       `protobuf:"varint,7,opt,name=FilledQuantity,proto3" json:"FilledQuantity,omitempty"`
 	Params         OrderParams `protobuf:"varint,8,opt,name=Params,proto3,enum=order.OrderParams" json:"Params,omitempty"`
+	// the good-till-date or good-for-day order expire at milliseconds
+	ExpireAtMilli int64 `protobuf:"varint,9,opt,name=ExpireAtMilli,proto3" json:"ExpireAtMilli,omitempty"`
+	// the price a pegged order follows
+	Peg OrderPeg `protobuf:"varint,10,opt,name=Peg,proto3,enum=order.OrderPeg" json:"Peg,omitempty"`
+	// the one-cancels-other group of the order
+	OCOGroupID string `protobuf:"bytes,11,opt,name=OCOGroupID,proto3" json:"OCOGroupID,omitempty"`
+	// the order id given by the customer
+	ClientOrderID string `protobuf:"bytes,12,opt,name=ClientOrderID,proto3" json:"ClientOrderID,omitempty"`
 }
 
 func (x *Order) Reset() {
@@ -285,6 +518,35 @@ func (x *Order) GetParams() OrderParams {
 	return OrderParams_ORDER_PARAMS_UNKNOWN
 }
 
+func (x *Order) GetExpireAtMilli() int64 {
+	if x != nil {
+		return x.ExpireAtMilli
+	}
+	return 0
+}
+
+func (x *Order) GetPeg() OrderPeg {
+	if x != nil {
+		return x.Peg
+	}
+	return OrderPeg_ORDER_PEG_NONE
+}
+
+func (x *Order) GetOCOGroupID() string {
+	if x != nil {
+		return x.OCOGroupID
+	}
+	return ""
+}
+
+func (x *Order) GetClientOrderID() string {
+	if x != nil {
+		return x.ClientOrderID
+	}
+	return ""
+}
+
+// Price is value object of price
 type Price struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -340,6 +602,7 @@ func (x *Price) GetExponent() int32 {
 	return 0
 }
 
+// SubmitOrderRequest define SubmitOrder request
 type SubmitOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -353,6 +616,36 @@ type SubmitOrderRequest struct {
 	Kind       OrderKind   `protobuf:"varint,6,opt,name=Kind,proto3,enum=order.OrderKind" json:"Kind,omitempty"`
 	Side       OrderSide   `protobuf:"varint,7,opt,name=Side,proto3,enum=order.OrderSide" json:"Side,omitempty"`
 	Params     OrderParams `protobuf:"varint,8,opt,name=Params,proto3,enum=order.OrderParams" json:"Params,omitempty"`
+	// the good-till-date order expire at milliseconds
+	// good-for-day orders expire at the end of the trading day when it is empty
+	ExpireAtMilli int64 `protobuf:"varint,9,opt,name=ExpireAtMilli,proto3" json:"ExpireAtMilli,omitempty"`
+	// the trailing stop distance from the market price
+	TrailAmount *Price `protobuf:"bytes,10,opt,name=TrailAmount,proto3" json:"TrailAmount,omitempty"`
+	// the trailing stop distance in percent of the market price, e.g. 5 means 5%
+	TrailPercent *Price `protobuf:"bytes,11,opt,name=TrailPercent,proto3" json:"TrailPercent,omitempty"`
+	// the iceberg order quantity shown in the books, zero shows the whole quantity
+	DisplayQuantity int64 `protobuf:"varint,12,opt,name=DisplayQuantity,proto3" json:"DisplayQuantity,omitempty"`
+	// the post-only order is repriced one tick behind the opposite best price instead of rejected
+	PostOnlySlide bool `protobuf:"varint,13,opt,name=PostOnlySlide,proto3" json:"PostOnlySlide,omitempty"`
+	// the price a pegged order follows, the Price is set by the order book
+	Peg OrderPeg `protobuf:"varint,14,opt,name=Peg,proto3,enum=order.OrderPeg" json:"Peg,omitempty"`
+	// added to the price a pegged order follows, can be negative
+	PegOffset *Price `protobuf:"bytes,15,opt,name=PegOffset,proto3" json:"PegOffset,omitempty"`
+	// the pegged order is never priced through this limit, zero means no limit
+	PegCap *Price `protobuf:"bytes,16,opt,name=PegCap,proto3" json:"PegCap,omitempty"`
+	// a fill of any order of the customer's one-cancels-other group cancels the others
+	OCOGroupID string `protobuf:"bytes,17,opt,name=OCOGroupID,proto3" json:"OCOGroupID,omitempty"`
+	// the self-trade prevention applied when the order would trade with the same customer or STP group
+	// the customer's self-trade prevention is used when it is empty
+	STP SelfTradePrevention `protobuf:"varint,18,opt,name=STP,proto3,enum=order.SelfTradePrevention" json:"STP,omitempty"`
+	// orders of customers sharing the self-trade prevention group never trade with each other
+	STPGroupID string `protobuf:"bytes,19,opt,name=STPGroupID,proto3" json:"STPGroupID,omitempty"`
+	// the order id given by the customer, unique among the customer's orders
+	// a retried request with the same client order id within the idempotency window returns the original reply
+	ClientOrderID string `protobuf:"bytes,20,opt,name=ClientOrderID,proto3" json:"ClientOrderID,omitempty"`
+	// the quote amount to buy or sell for instead of the Quantity, for market and marketable limit orders
+	// the filled quantity is rounded down to the lot size
+	Notional *Price `protobuf:"bytes,21,opt,name=Notional,proto3" json:"Notional,omitempty"`
 }
 
 func (x *SubmitOrderRequest) Reset() {
@@ -443,13 +736,117 @@ func (x *SubmitOrderRequest) GetParams() OrderParams {
 	return OrderParams_ORDER_PARAMS_UNKNOWN
 }
 
+func (x *SubmitOrderRequest) GetExpireAtMilli() int64 {
+	if x != nil {
+		return x.ExpireAtMilli
+	}
+	return 0
+}
+
+func (x *SubmitOrderRequest) GetTrailAmount() *Price {
+	if x != nil {
+		return x.TrailAmount
+	}
+	return nil
+}
+
+func (x *SubmitOrderRequest) GetTrailPercent() *Price {
+	if x != nil {
+		return x.TrailPercent
+	}
+	return nil
+}
+
+func (x *SubmitOrderRequest) GetDisplayQuantity() int64 {
+	if x != nil {
+		return x.DisplayQuantity
+	}
+	return 0
+}
+
+func (x *SubmitOrderRequest) GetPostOnlySlide() bool {
+	if x != nil {
+		return x.PostOnlySlide
+	}
+	return false
+}
+
+func (x *SubmitOrderRequest) GetPeg() OrderPeg {
+	if x != nil {
+		return x.Peg
+	}
+	return OrderPeg_ORDER_PEG_NONE
+}
+
+func (x *SubmitOrderRequest) GetPegOffset() *Price {
+	if x != nil {
+		return x.PegOffset
+	}
+	return nil
+}
+
+func (x *SubmitOrderRequest) GetPegCap() *Price {
+	if x != nil {
+		return x.PegCap
+	}
+	return nil
+}
+
+func (x *SubmitOrderRequest) GetOCOGroupID() string {
+	if x != nil {
+		return x.OCOGroupID
+	}
+	return ""
+}
+
+func (x *SubmitOrderRequest) GetSTP() SelfTradePrevention {
+	if x != nil {
+		return x.STP
+	}
+	return SelfTradePrevention_STP_NONE
+}
+
+func (x *SubmitOrderRequest) GetSTPGroupID() string {
+	if x != nil {
+		return x.STPGroupID
+	}
+	return ""
+}
+
+func (x *SubmitOrderRequest) GetClientOrderID() string {
+	if x != nil {
+		return x.ClientOrderID
+	}
+	return ""
+}
+
+func (x *SubmitOrderRequest) GetNotional() *Price {
+	if x != nil {
+		return x.Notional
+	}
+	return nil
+}
+
+// SubmitOrderReply define SubmitOrder reply
 type SubmitOrderReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID        string `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
-	CreatedAtMilli int64  `protobuf:"varint,2,opt,name=CreatedAtMilli,proto3" json:"CreatedAtMilli,omitempty"`
+	OrderID string `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	// the order create at milliseconds
+	CreatedAtMilli int64 `protobuf:"varint,2,opt,name=CreatedAtMilli,proto3" json:"CreatedAtMilli,omitempty"`
+	// the order price after it was placed in the order book
+	Price          *Price `protobuf:"bytes,3,opt,name=Price,proto3" json:"Price,omitempty"`
+	FilledQuantity int64  `protobuf:"varint,4,opt,name=FilledQuantity,proto3" json:"FilledQuantity,omitempty"`
+	// the post-only order was repriced to not take liquidity
+	Repriced bool `protobuf:"varint,5,opt,name=Repriced,proto3" json:"Repriced,omitempty"`
+	// the order was submitted before with the same client order id, this is the original reply
+	Duplicate bool `protobuf:"varint,6,opt,name=Duplicate,proto3" json:"Duplicate,omitempty"`
+	// what happened to the rest of a market order by the market policy of the symbol
+	MarketRemainder MarketRemainder `protobuf:"varint,7,opt,name=MarketRemainder,proto3,enum=order.MarketRemainder" json:"MarketRemainder,omitempty"`
+	// the executed quote amount of a notional order
+	FilledNotional *Price `protobuf:"bytes,8,opt,name=FilledNotional,proto3" json:"FilledNotional,omitempty"`
 }
 
 func (x *SubmitOrderReply) Reset() {
@@ -498,16 +895,65 @@ func (x *SubmitOrderReply) GetCreatedAtMilli() int64 {
 	return 0
 }
 
-type ListAllAsksRequest struct {
+func (x *SubmitOrderReply) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *SubmitOrderReply) GetFilledQuantity() int64 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *SubmitOrderReply) GetRepriced() bool {
+	if x != nil {
+		return x.Repriced
+	}
+	return false
+}
+
+func (x *SubmitOrderReply) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *SubmitOrderReply) GetMarketRemainder() MarketRemainder {
+	if x != nil {
+		return x.MarketRemainder
+	}
+	return MarketRemainder_MARKET_REMAINDER_NONE
+}
+
+func (x *SubmitOrderReply) GetFilledNotional() *Price {
+	if x != nil {
+		return x.FilledNotional
+	}
+	return nil
+}
+
+// SubmitBracketOrderRequest define SubmitBracketOrder request
+type SubmitBracketOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbol string `protobuf:"bytes,1,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
+	Entry *SubmitOrderRequest `protobuf:"bytes,1,opt,name=Entry,proto3" json:"Entry,omitempty"`
+	// the take-profit limit price, there is no take-profit order when it is empty
+	TakeProfitPrice *Price `protobuf:"bytes,2,opt,name=TakeProfitPrice,proto3" json:"TakeProfitPrice,omitempty"`
+	// the stop-loss stop price, there is no stop-loss order when it is empty
+	StopLossStopPrice *Price `protobuf:"bytes,3,opt,name=StopLossStopPrice,proto3" json:"StopLossStopPrice,omitempty"`
+	// the stop-loss limit price, the stop-loss is a stop-market order when it is empty
+	StopLossPrice *Price `protobuf:"bytes,4,opt,name=StopLossPrice,proto3" json:"StopLossPrice,omitempty"`
 }
 
-func (x *ListAllAsksRequest) Reset() {
-	*x = ListAllAsksRequest{}
+func (x *SubmitBracketOrderRequest) Reset() {
+	*x = SubmitBracketOrderRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_order_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,13 +961,13 @@ func (x *ListAllAsksRequest) Reset() {
 	}
 }
 
-func (x *ListAllAsksRequest) String() string {
+func (x *SubmitBracketOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllAsksRequest) ProtoMessage() {}
+func (*SubmitBracketOrderRequest) ProtoMessage() {}
 
-func (x *ListAllAsksRequest) ProtoReflect() protoreflect.Message {
+func (x *SubmitBracketOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_order_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -533,28 +979,52 @@ func (x *ListAllAsksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllAsksRequest.ProtoReflect.Descriptor instead.
-func (*ListAllAsksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitBracketOrderRequest.ProtoReflect.Descriptor instead.
+func (*SubmitBracketOrderRequest) Descriptor() ([]byte, []int) {
 	return file_order_order_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListAllAsksRequest) GetSymbol() string {
+func (x *SubmitBracketOrderRequest) GetEntry() *SubmitOrderRequest {
 	if x != nil {
-		return x.Symbol
+		return x.Entry
 	}
-	return ""
+	return nil
 }
 
-type ListAllAskReply struct {
+func (x *SubmitBracketOrderRequest) GetTakeProfitPrice() *Price {
+	if x != nil {
+		return x.TakeProfitPrice
+	}
+	return nil
+}
+
+func (x *SubmitBracketOrderRequest) GetStopLossStopPrice() *Price {
+	if x != nil {
+		return x.StopLossStopPrice
+	}
+	return nil
+}
+
+func (x *SubmitBracketOrderRequest) GetStopLossPrice() *Price {
+	if x != nil {
+		return x.StopLossPrice
+	}
+	return nil
+}
+
+// SubmitBracketOrderReply define SubmitBracketOrder reply
+type SubmitBracketOrderReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Orders []*Order `protobuf:"bytes,1,rep,name=Orders,proto3" json:"Orders,omitempty"`
+	Entry             *SubmitOrderReply `protobuf:"bytes,1,opt,name=Entry,proto3" json:"Entry,omitempty"`
+	TakeProfitOrderID string            `protobuf:"bytes,2,opt,name=TakeProfitOrderID,proto3" json:"TakeProfitOrderID,omitempty"`
+	StopLossOrderID   string            `protobuf:"bytes,3,opt,name=StopLossOrderID,proto3" json:"StopLossOrderID,omitempty"`
 }
 
-func (x *ListAllAskReply) Reset() {
-	*x = ListAllAskReply{}
+func (x *SubmitBracketOrderReply) Reset() {
+	*x = SubmitBracketOrderReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_order_order_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -562,13 +1032,13 @@ func (x *ListAllAskReply) Reset() {
 	}
 }
 
-func (x *ListAllAskReply) String() string {
+func (x *SubmitBracketOrderReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllAskReply) ProtoMessage() {}
+func (*SubmitBracketOrderReply) ProtoMessage() {}
 
-func (x *ListAllAskReply) ProtoReflect() protoreflect.Message {
+func (x *SubmitBracketOrderReply) ProtoReflect() protoreflect.Message {
 	mi := &file_order_order_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -580,20 +1050,1244 @@ func (x *ListAllAskReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllAskReply.ProtoReflect.Descriptor instead.
-func (*ListAllAskReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitBracketOrderReply.ProtoReflect.Descriptor instead.
+func (*SubmitBracketOrderReply) Descriptor() ([]byte, []int) {
 	return file_order_order_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListAllAskReply) GetOrders() []*Order {
+func (x *SubmitBracketOrderReply) GetEntry() *SubmitOrderReply {
 	if x != nil {
-		return x.Orders
+		return x.Entry
 	}
 	return nil
 }
 
-type ListAllBidsRequest struct {
-	state         protoimpl.MessageState
+func (x *SubmitBracketOrderReply) GetTakeProfitOrderID() string {
+	if x != nil {
+		return x.TakeProfitOrderID
+	}
+	return ""
+}
+
+func (x *SubmitBracketOrderReply) GetStopLossOrderID() string {
+	if x != nil {
+		return x.StopLossOrderID
+	}
+	return ""
+}
+
+// CancelOrderRequest define CancelOrder request
+// the order is addressed by the OrderID or by the CustomerID and the ClientOrderID
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol        string `protobuf:"bytes,1,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
+	OrderID       string `protobuf:"bytes,2,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	CustomerID    string `protobuf:"bytes,3,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	ClientOrderID string `protobuf:"bytes,4,opt,name=ClientOrderID,proto3" json:"ClientOrderID,omitempty"`
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelOrderRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetCustomerID() string {
+	if x != nil {
+		return x.CustomerID
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetClientOrderID() string {
+	if x != nil {
+		return x.ClientOrderID
+	}
+	return ""
+}
+
+// CancelOrderReply define CancelOrder reply
+type CancelOrderReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID string `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	// the quantity filled before the order was cancelled
+	FilledQuantity int64 `protobuf:"varint,2,opt,name=FilledQuantity,proto3" json:"FilledQuantity,omitempty"`
+	// the cancelled quantity which was not filled
+	RemainingQuantity int64 `protobuf:"varint,3,opt,name=RemainingQuantity,proto3" json:"RemainingQuantity,omitempty"`
+}
+
+func (x *CancelOrderReply) Reset() {
+	*x = CancelOrderReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderReply) ProtoMessage() {}
+
+func (x *CancelOrderReply) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderReply.ProtoReflect.Descriptor instead.
+func (*CancelOrderReply) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelOrderReply) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *CancelOrderReply) GetFilledQuantity() int64 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *CancelOrderReply) GetRemainingQuantity() int64 {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return 0
+}
+
+// ReplaceOrderRequest define ReplaceOrder request
+type ReplaceOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol  string `protobuf:"bytes,1,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
+	OrderID string `protobuf:"bytes,2,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	// the new quantity including the filled quantity, zero keeps the quantity
+	Quantity int64 `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	// the new limit price, empty keeps the price
+	Price *Price `protobuf:"bytes,4,opt,name=Price,proto3" json:"Price,omitempty"`
+	// the order is addressed by the CustomerID and the ClientOrderID when the OrderID is empty
+	CustomerID    string `protobuf:"bytes,5,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	ClientOrderID string `protobuf:"bytes,6,opt,name=ClientOrderID,proto3" json:"ClientOrderID,omitempty"`
+}
+
+func (x *ReplaceOrderRequest) Reset() {
+	*x = ReplaceOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceOrderRequest) ProtoMessage() {}
+
+func (x *ReplaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReplaceOrderRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ReplaceOrderRequest) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *ReplaceOrderRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReplaceOrderRequest) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ReplaceOrderRequest) GetCustomerID() string {
+	if x != nil {
+		return x.CustomerID
+	}
+	return ""
+}
+
+func (x *ReplaceOrderRequest) GetClientOrderID() string {
+	if x != nil {
+		return x.ClientOrderID
+	}
+	return ""
+}
+
+// ReplaceOrderReply define ReplaceOrder reply
+type ReplaceOrderReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID        string `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	Price          *Price `protobuf:"bytes,2,opt,name=Price,proto3" json:"Price,omitempty"`
+	Quantity       int64  `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	FilledQuantity int64  `protobuf:"varint,4,opt,name=FilledQuantity,proto3" json:"FilledQuantity,omitempty"`
+	// the order lost its time priority
+	Requeued bool `protobuf:"varint,5,opt,name=Requeued,proto3" json:"Requeued,omitempty"`
+}
+
+func (x *ReplaceOrderReply) Reset() {
+	*x = ReplaceOrderReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceOrderReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceOrderReply) ProtoMessage() {}
+
+func (x *ReplaceOrderReply) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceOrderReply.ProtoReflect.Descriptor instead.
+func (*ReplaceOrderReply) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReplaceOrderReply) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *ReplaceOrderReply) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ReplaceOrderReply) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReplaceOrderReply) GetFilledQuantity() int64 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *ReplaceOrderReply) GetRequeued() bool {
+	if x != nil {
+		return x.Requeued
+	}
+	return false
+}
+
+// MassCancelRequest define MassCancel request, empty fields match every order but at least one has to be set
+type MassCancelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerID string    `protobuf:"bytes,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	Symbol     string    `protobuf:"bytes,2,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
+	Side       OrderSide `protobuf:"varint,3,opt,name=Side,proto3,enum=order.OrderSide" json:"Side,omitempty"`
+}
+
+func (x *MassCancelRequest) Reset() {
+	*x = MassCancelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MassCancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MassCancelRequest) ProtoMessage() {}
+
+func (x *MassCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MassCancelRequest.ProtoReflect.Descriptor instead.
+func (*MassCancelRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MassCancelRequest) GetCustomerID() string {
+	if x != nil {
+		return x.CustomerID
+	}
+	return ""
+}
+
+func (x *MassCancelRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *MassCancelRequest) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNKNOWN
+}
+
+// MassCancelOutcome define the outcome of a cancelled order
+type MassCancelOutcome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID   string `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	Symbol    string `protobuf:"bytes,2,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
+	Cancelled bool   `protobuf:"varint,3,opt,name=Cancelled,proto3" json:"Cancelled,omitempty"`
+	// the reason the order was not cancelled
+	Error             string `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	FilledQuantity    int64  `protobuf:"varint,5,opt,name=FilledQuantity,proto3" json:"FilledQuantity,omitempty"`
+	RemainingQuantity int64  `protobuf:"varint,6,opt,name=RemainingQuantity,proto3" json:"RemainingQuantity,omitempty"`
+}
+
+func (x *MassCancelOutcome) Reset() {
+	*x = MassCancelOutcome{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MassCancelOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MassCancelOutcome) ProtoMessage() {}
+
+func (x *MassCancelOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MassCancelOutcome.ProtoReflect.Descriptor instead.
+func (*MassCancelOutcome) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MassCancelOutcome) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *MassCancelOutcome) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *MassCancelOutcome) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *MassCancelOutcome) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MassCancelOutcome) GetFilledQuantity() int64 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *MassCancelOutcome) GetRemainingQuantity() int64 {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return 0
+}
+
+// MassCancelReply define MassCancel reply
+type MassCancelReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcomes []*MassCancelOutcome `protobuf:"bytes,1,rep,name=Outcomes,proto3" json:"Outcomes,omitempty"`
+}
+
+func (x *MassCancelReply) Reset() {
+	*x = MassCancelReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MassCancelReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MassCancelReply) ProtoMessage() {}
+
+func (x *MassCancelReply) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MassCancelReply.ProtoReflect.Descriptor instead.
+func (*MassCancelReply) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MassCancelReply) GetOutcomes() []*MassCancelOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+// SessionRequest define Session request
+type SessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the customer of the session, set by the first request
+	CustomerID string `protobuf:"bytes,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	// the orders are cancelled when there is no request within the timeout, set by the first request
+	// the default timeout is used when it is empty
+	HeartbeatTimeoutMilli int64 `protobuf:"varint,2,opt,name=HeartbeatTimeoutMilli,proto3" json:"HeartbeatTimeoutMilli,omitempty"`
+}
+
+func (x *SessionRequest) Reset() {
+	*x = SessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRequest) ProtoMessage() {}
+
+func (x *SessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRequest.ProtoReflect.Descriptor instead.
+func (*SessionRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SessionRequest) GetCustomerID() string {
+	if x != nil {
+		return x.CustomerID
+	}
+	return ""
+}
+
+func (x *SessionRequest) GetHeartbeatTimeoutMilli() int64 {
+	if x != nil {
+		return x.HeartbeatTimeoutMilli
+	}
+	return 0
+}
+
+// SessionReply define Session reply
+type SessionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	// the heartbeat acknowledge time in milliseconds
+	TimestampMilli int64 `protobuf:"varint,2,opt,name=TimestampMilli,proto3" json:"TimestampMilli,omitempty"`
+}
+
+func (x *SessionReply) Reset() {
+	*x = SessionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionReply) ProtoMessage() {}
+
+func (x *SessionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionReply.ProtoReflect.Descriptor instead.
+func (*SessionReply) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SessionReply) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SessionReply) GetTimestampMilli() int64 {
+	if x != nil {
+		return x.TimestampMilli
+	}
+	return 0
+}
+
+// SetSelfTradePreventionRequest define SetSelfTradePrevention request
+type SetSelfTradePreventionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerID string              `protobuf:"bytes,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	STP        SelfTradePrevention `protobuf:"varint,2,opt,name=STP,proto3,enum=order.SelfTradePrevention" json:"STP,omitempty"`
+	STPGroupID string              `protobuf:"bytes,3,opt,name=STPGroupID,proto3" json:"STPGroupID,omitempty"`
+}
+
+func (x *SetSelfTradePreventionRequest) Reset() {
+	*x = SetSelfTradePreventionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSelfTradePreventionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSelfTradePreventionRequest) ProtoMessage() {}
+
+func (x *SetSelfTradePreventionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSelfTradePreventionRequest.ProtoReflect.Descriptor instead.
+func (*SetSelfTradePreventionRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetSelfTradePreventionRequest) GetCustomerID() string {
+	if x != nil {
+		return x.CustomerID
+	}
+	return ""
+}
+
+func (x *SetSelfTradePreventionRequest) GetSTP() SelfTradePrevention {
+	if x != nil {
+		return x.STP
+	}
+	return SelfTradePrevention_STP_NONE
+}
+
+func (x *SetSelfTradePreventionRequest) GetSTPGroupID() string {
+	if x != nil {
+		return x.STPGroupID
+	}
+	return ""
+}
+
+// SetSelfTradePreventionReply define SetSelfTradePrevention reply
+type SetSelfTradePreventionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetSelfTradePreventionReply) Reset() {
+	*x = SetSelfTradePreventionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSelfTradePreventionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSelfTradePreventionReply) ProtoMessage() {}
+
+func (x *SetSelfTradePreventionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSelfTradePreventionReply.ProtoReflect.Descriptor instead.
+func (*SetSelfTradePreventionReply) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{16}
+}
+
+// HaltTradingRequest define HaltTrading request
+type HaltTradingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
+	// the reason returned to the orders sent during the halt
+	Reason string `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *HaltTradingRequest) Reset() {
+	*x = HaltTradingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HaltTradingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaltTradingRequest) ProtoMessage() {}
+
+func (x *HaltTradingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaltTradingRequest.ProtoReflect.Descriptor instead.
+func (*HaltTradingRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HaltTradingRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *HaltTradingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// HaltTradingReply define HaltTrading reply
+type HaltTradingReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HaltTradingReply) Reset() {
+	*x = HaltTradingReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HaltTradingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaltTradingReply) ProtoMessage() {}
+
+func (x *HaltTradingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaltTradingReply.ProtoReflect.Descriptor instead.
+func (*HaltTradingReply) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{18}
+}
+
+// ResumeTradingRequest define ResumeTrading request
+type ResumeTradingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
+	// orders are collected without matching during the re-opening period and uncrossed once it ends
+	// zero resumes continuous trading straight away
+	ReopenAfterMilli int64 `protobuf:"varint,2,opt,name=ReopenAfterMilli,proto3" json:"ReopenAfterMilli,omitempty"`
+}
+
+func (x *ResumeTradingRequest) Reset() {
+	*x = ResumeTradingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeTradingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTradingRequest) ProtoMessage() {}
+
+func (x *ResumeTradingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTradingRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTradingRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResumeTradingRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ResumeTradingRequest) GetReopenAfterMilli() int64 {
+	if x != nil {
+		return x.ReopenAfterMilli
+	}
+	return 0
+}
+
+// ResumeTradingReply define ResumeTrading reply
+type ResumeTradingReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the order book is still halted until the re-opening
+	Halted bool `protobuf:"varint,1,opt,name=Halted,proto3" json:"Halted,omitempty"`
+	// continuous trading resumes at milliseconds
+	ResumeAtMilli int64 `protobuf:"varint,2,opt,name=ResumeAtMilli,proto3" json:"ResumeAtMilli,omitempty"`
+}
+
+func (x *ResumeTradingReply) Reset() {
+	*x = ResumeTradingReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeTradingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTradingReply) ProtoMessage() {}
+
+func (x *ResumeTradingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTradingReply.ProtoReflect.Descriptor instead.
+func (*ResumeTradingReply) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResumeTradingReply) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+func (x *ResumeTradingReply) GetResumeAtMilli() int64 {
+	if x != nil {
+		return x.ResumeAtMilli
+	}
+	return 0
+}
+
+// GetTradingPhaseRequest define GetTradingPhase request
+type GetTradingPhaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// every order book when it is empty
+	Symbol string `protobuf:"bytes,1,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
+}
+
+func (x *GetTradingPhaseRequest) Reset() {
+	*x = GetTradingPhaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTradingPhaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradingPhaseRequest) ProtoMessage() {}
+
+func (x *GetTradingPhaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradingPhaseRequest.ProtoReflect.Descriptor instead.
+func (*GetTradingPhaseRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTradingPhaseRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+// SymbolTradingPhase define the trading phase of an order book
+type SymbolTradingPhase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string       `protobuf:"bytes,1,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
+	Phase  TradingPhase `protobuf:"varint,2,opt,name=Phase,proto3,enum=order.TradingPhase" json:"Phase,omitempty"`
+	Halted bool         `protobuf:"varint,3,opt,name=Halted,proto3" json:"Halted,omitempty"`
+	// the scheduled time of the next phase in milliseconds, zero without schedule
+	NextPhaseAtMilli int64 `protobuf:"varint,4,opt,name=NextPhaseAtMilli,proto3" json:"NextPhaseAtMilli,omitempty"`
+}
+
+func (x *SymbolTradingPhase) Reset() {
+	*x = SymbolTradingPhase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymbolTradingPhase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolTradingPhase) ProtoMessage() {}
+
+func (x *SymbolTradingPhase) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolTradingPhase.ProtoReflect.Descriptor instead.
+func (*SymbolTradingPhase) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SymbolTradingPhase) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SymbolTradingPhase) GetPhase() TradingPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return TradingPhase_TRADING_PHASE_UNSPECIFIED
+}
+
+func (x *SymbolTradingPhase) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+func (x *SymbolTradingPhase) GetNextPhaseAtMilli() int64 {
+	if x != nil {
+		return x.NextPhaseAtMilli
+	}
+	return 0
+}
+
+// GetTradingPhaseReply define GetTradingPhase reply
+type GetTradingPhaseReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phases []*SymbolTradingPhase `protobuf:"bytes,1,rep,name=Phases,proto3" json:"Phases,omitempty"`
+}
+
+func (x *GetTradingPhaseReply) Reset() {
+	*x = GetTradingPhaseReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTradingPhaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradingPhaseReply) ProtoMessage() {}
+
+func (x *GetTradingPhaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradingPhaseReply.ProtoReflect.Descriptor instead.
+func (*GetTradingPhaseReply) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTradingPhaseReply) GetPhases() []*SymbolTradingPhase {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+// ListAllAsksRequest define list all asks of request
+type ListAllAsksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
+}
+
+func (x *ListAllAsksRequest) Reset() {
+	*x = ListAllAsksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllAsksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllAsksRequest) ProtoMessage() {}
+
+func (x *ListAllAsksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllAsksRequest.ProtoReflect.Descriptor instead.
+func (*ListAllAsksRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAllAsksRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+// ListAllAskReply define list all asks of reply
+type ListAllAskReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*Order `protobuf:"bytes,1,rep,name=Orders,proto3" json:"Orders,omitempty"`
+}
+
+func (x *ListAllAskReply) Reset() {
+	*x = ListAllAskReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllAskReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllAskReply) ProtoMessage() {}
+
+func (x *ListAllAskReply) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllAskReply.ProtoReflect.Descriptor instead.
+func (*ListAllAskReply) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAllAskReply) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+// ListAllBidsRequest define list all bids of request
+type ListAllBidsRequest struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
@@ -603,7 +2297,7 @@ type ListAllBidsRequest struct {
 func (x *ListAllBidsRequest) Reset() {
 	*x = ListAllBidsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[6]
+		mi := &file_order_order_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -616,7 +2310,7 @@ func (x *ListAllBidsRequest) String() string {
 func (*ListAllBidsRequest) ProtoMessage() {}
 
 func (x *ListAllBidsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[6]
+	mi := &file_order_order_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +2323,7 @@ func (x *ListAllBidsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllBidsRequest.ProtoReflect.Descriptor instead.
 func (*ListAllBidsRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{6}
+	return file_order_order_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListAllBidsRequest) GetSymbol() string {
@@ -639,6 +2333,7 @@ func (x *ListAllBidsRequest) GetSymbol() string {
 	return ""
 }
 
+// ListAllBidsReply  define list all bids of reply
 type ListAllBidsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -650,7 +2345,7 @@ type ListAllBidsReply struct {
 func (x *ListAllBidsReply) Reset() {
 	*x = ListAllBidsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[7]
+		mi := &file_order_order_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -663,7 +2358,7 @@ func (x *ListAllBidsReply) String() string {
 func (*ListAllBidsReply) ProtoMessage() {}
 
 func (x *ListAllBidsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[7]
+	mi := &file_order_order_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +2371,7 @@ func (x *ListAllBidsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllBidsReply.ProtoReflect.Descriptor instead.
 func (*ListAllBidsReply) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{7}
+	return file_order_order_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListAllBidsReply) GetOrders() []*Order {
@@ -690,7 +2385,7 @@ var File_order_order_proto protoreflect.FileDescriptor
 
 var file_order_order_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xa5, 0x02, 0x0a, 0x05, 0x4f,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xb4, 0x03, 0x0a, 0x05, 0x4f,
 	0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
@@ -709,86 +2404,371 @@ var file_order_order_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f,
 	0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x22, 0x45, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43,
-	0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0xb0, 0x02, 0x0a, 0x12, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x41, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x12, 0x21, 0x0a, 0x03, 0x50, 0x65, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x50, 0x65, 0x67, 0x52, 0x03, 0x50, 0x65, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x4f,
+	0x43, 0x4f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x4f, 0x43, 0x4f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x45, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f,
+	0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0xbb, 0x06, 0x0a, 0x12, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x53, 0x69, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x53, 0x69, 0x64, 0x65, 0x12,
+	0x2a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x12, 0x2e, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x0b, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a,
+	0x0d, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x53, 0x6c,
+	0x69, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x50, 0x65, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x65,
+	0x67, 0x52, 0x03, 0x50, 0x65, 0x67, 0x12, 0x2a, 0x0a, 0x09, 0x50, 0x65, 0x67, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x09, 0x50, 0x65, 0x67, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x50, 0x65, 0x67, 0x43, 0x61, 0x70, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x06, 0x50, 0x65, 0x67, 0x43, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x43, 0x4f, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x43,
+	0x4f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x03, 0x53, 0x54, 0x50, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x6c, 0x66, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x03, 0x53, 0x54, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x54, 0x50, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x54, 0x50, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x08,
+	0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x08, 0x4e, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0xd2, 0x02, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x12, 0x22, 0x0a,
+	0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x52, 0x65, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0e, 0x46, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0xf4, 0x01, 0x0a, 0x19,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x0f, 0x54, 0x61,
+	0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x53, 0x69,
-	0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x65, 0x52, 0x0f, 0x54, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x53, 0x74,
+	0x6f, 0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x11, 0x53, 0x74, 0x6f,
+	0x70, 0x4c, 0x6f, 0x73, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x32,
+	0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d,
+	0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a,
+	0x11, 0x54, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x54, 0x61, 0x6b, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x53,
+	0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24,
+	0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x52,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xcd, 0x01, 0x0a, 0x13, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x22, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0xb1, 0x01, 0x0a, 0x11, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x05, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x22, 0x71, 0x0a,
+	0x11, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x24, 0x0a, 0x04, 0x53, 0x69,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
 	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x53, 0x69, 0x64, 0x65,
-	0x12, 0x2a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x54, 0x0a, 0x10,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x69, 0x6c,
-	0x6c, 0x69, 0x22, 0x2c, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x6b,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x22, 0x37, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x6b, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x06, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x38, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x6c, 0x6c, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x2a, 0xc2, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d,
-	0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x50,
-	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41,
-	0x4d, 0x53, 0x5f, 0x41, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45,
-	0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x49, 0x4f, 0x43, 0x10, 0x03, 0x12, 0x14,
-	0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x46,
-	0x4f, 0x4b, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41,
-	0x52, 0x41, 0x4d, 0x53, 0x5f, 0x47, 0x54, 0x43, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x47, 0x46, 0x44, 0x10, 0x06,
-	0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53,
-	0x5f, 0x47, 0x54, 0x44, 0x10, 0x07, 0x2a, 0x50, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4b,
-	0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e,
-	0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54,
-	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
-	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x02, 0x2a, 0x4c, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x53, 0x69, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53,
-	0x49, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x10,
-	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f,
-	0x53, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x32, 0xe4, 0x01, 0x0a, 0x14, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x43, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19,
-	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64,
+	0x22, 0xcf, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e,
+	0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0x47, 0x0a, 0x0f, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x52, 0x08, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x0e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x34, 0x0a,
+	0x15, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x22, 0x54, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x22, 0x8d, 0x01, 0x0a, 0x1d, 0x53, 0x65,
+	0x74, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x03, 0x53,
+	0x54, 0x50, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x53, 0x54, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x54, 0x50,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53,
+	0x54, 0x50, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74,
+	0x53, 0x65, 0x6c, 0x66, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x44, 0x0a, 0x12, 0x48, 0x61, 0x6c, 0x74,
+	0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x12,
+	0x0a, 0x10, 0x48, 0x61, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x5a, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65,
+	0x6f, 0x70, 0x65, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x22, 0x52,
+	0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x48, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x74, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x22, 0x30, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x9b, 0x01, 0x0a, 0x12, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x48, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x48, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x41, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x41, 0x74, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x22, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x06, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x22, 0x2c, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x37, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24,
+	0x0a, 0x06, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x42,
+	0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x22, 0x38, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2a, 0xfe, 0x01, 0x0a,
+	0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x14,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x41, 0x4f,
+	0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52,
+	0x41, 0x4d, 0x53, 0x5f, 0x49, 0x4f, 0x43, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x46, 0x4f, 0x4b, 0x10, 0x04, 0x12,
+	0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f,
+	0x47, 0x54, 0x43, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50,
+	0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x47, 0x46, 0x44, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x47, 0x54, 0x44, 0x10,
+	0x07, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d,
+	0x53, 0x5f, 0x54, 0x52, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10,
+	0x08, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d,
+	0x53, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x09, 0x2a, 0x50, 0x0a,
+	0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x02, 0x2a,
+	0x4c, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x49,
+	0x44, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x2a, 0x66, 0x0a,
+	0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x65, 0x67, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x50, 0x45, 0x47, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x45, 0x47, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x5f,
+	0x42, 0x49, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50,
+	0x45, 0x47, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x53, 0x4b, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x45, 0x47, 0x5f, 0x4d, 0x49, 0x44, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x10, 0x03, 0x2a, 0x84, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x50, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a,
+	0x08, 0x53, 0x54, 0x50, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53,
+	0x54, 0x50, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54,
+	0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x50, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x50,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x12, 0x1c,
+	0x0a, 0x18, 0x53, 0x54, 0x50, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x04, 0x2a, 0x9c, 0x01, 0x0a,
+	0x0c, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x19, 0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x49, 0x4e, 0x55, 0x4f, 0x55, 0x53, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52,
+	0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x5f,
+	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x49, 0x4e, 0x47, 0x10,
+	0x03, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x48, 0x41,
+	0x53, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x6a, 0x0a, 0x0f, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x19,
+	0x0a, 0x15, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x41, 0x49, 0x4e, 0x44,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x41, 0x52,
+	0x4b, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x41, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x41, 0x52,
+	0x4b, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x41, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x49,
+	0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x02, 0x32, 0x91, 0x07, 0x0a, 0x14, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x43, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x43, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64,
 	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41,
-	0x73, 0x6b, 0x73, 0x12, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x6c, 0x6c, 0x41, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73,
-	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64, 0x73, 0x12, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x6c, 0x6c, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x09, 0x5a,
-	0x07, 0x2e, 0x3b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a,
+	0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x73,
+	0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x64, 0x0a, 0x16, 0x53,
+	0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x74, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0b, 0x48, 0x61, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6c, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x6b,
+	0x73, 0x12, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x41, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x6b, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x42, 0x69, 0x64, 0x73, 0x12, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c,
+	0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x09, 0x5a, 0x07, 0x2e,
+	0x3b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -803,44 +2783,109 @@ func file_order_order_proto_rawDescGZIP() []byte {
 	return file_order_order_proto_rawDescData
 }
 
-var file_order_order_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_order_order_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_order_order_proto_goTypes = []interface{}{
-	(OrderParams)(0),           // 0: order.OrderParams
-	(OrderKind)(0),             // 1: order.OrderKind
-	(OrderSide)(0),             // 2: order.OrderSide
-	(*Order)(nil),              // 3: order.Order
-	(*Price)(nil),              // 4: order.Price
-	(*SubmitOrderRequest)(nil), // 5: order.SubmitOrderRequest
-	(*SubmitOrderReply)(nil),   // 6: order.SubmitOrderReply
-	(*ListAllAsksRequest)(nil), // 7: order.ListAllAsksRequest
-	(*ListAllAskReply)(nil),    // 8: order.ListAllAskReply
-	(*ListAllBidsRequest)(nil), // 9: order.ListAllBidsRequest
-	(*ListAllBidsReply)(nil),   // 10: order.ListAllBidsReply
+	(OrderParams)(0),                      // 0: order.OrderParams
+	(OrderKind)(0),                        // 1: order.OrderKind
+	(OrderSide)(0),                        // 2: order.OrderSide
+	(OrderPeg)(0),                         // 3: order.OrderPeg
+	(SelfTradePrevention)(0),              // 4: order.SelfTradePrevention
+	(TradingPhase)(0),                     // 5: order.TradingPhase
+	(MarketRemainder)(0),                  // 6: order.MarketRemainder
+	(*Order)(nil),                         // 7: order.Order
+	(*Price)(nil),                         // 8: order.Price
+	(*SubmitOrderRequest)(nil),            // 9: order.SubmitOrderRequest
+	(*SubmitOrderReply)(nil),              // 10: order.SubmitOrderReply
+	(*SubmitBracketOrderRequest)(nil),     // 11: order.SubmitBracketOrderRequest
+	(*SubmitBracketOrderReply)(nil),       // 12: order.SubmitBracketOrderReply
+	(*CancelOrderRequest)(nil),            // 13: order.CancelOrderRequest
+	(*CancelOrderReply)(nil),              // 14: order.CancelOrderReply
+	(*ReplaceOrderRequest)(nil),           // 15: order.ReplaceOrderRequest
+	(*ReplaceOrderReply)(nil),             // 16: order.ReplaceOrderReply
+	(*MassCancelRequest)(nil),             // 17: order.MassCancelRequest
+	(*MassCancelOutcome)(nil),             // 18: order.MassCancelOutcome
+	(*MassCancelReply)(nil),               // 19: order.MassCancelReply
+	(*SessionRequest)(nil),                // 20: order.SessionRequest
+	(*SessionReply)(nil),                  // 21: order.SessionReply
+	(*SetSelfTradePreventionRequest)(nil), // 22: order.SetSelfTradePreventionRequest
+	(*SetSelfTradePreventionReply)(nil),   // 23: order.SetSelfTradePreventionReply
+	(*HaltTradingRequest)(nil),            // 24: order.HaltTradingRequest
+	(*HaltTradingReply)(nil),              // 25: order.HaltTradingReply
+	(*ResumeTradingRequest)(nil),          // 26: order.ResumeTradingRequest
+	(*ResumeTradingReply)(nil),            // 27: order.ResumeTradingReply
+	(*GetTradingPhaseRequest)(nil),        // 28: order.GetTradingPhaseRequest
+	(*SymbolTradingPhase)(nil),            // 29: order.SymbolTradingPhase
+	(*GetTradingPhaseReply)(nil),          // 30: order.GetTradingPhaseReply
+	(*ListAllAsksRequest)(nil),            // 31: order.ListAllAsksRequest
+	(*ListAllAskReply)(nil),               // 32: order.ListAllAskReply
+	(*ListAllBidsRequest)(nil),            // 33: order.ListAllBidsRequest
+	(*ListAllBidsReply)(nil),              // 34: order.ListAllBidsReply
 }
 var file_order_order_proto_depIdxs = []int32{
 	1,  // 0: order.Order.Kind:type_name -> order.OrderKind
-	4,  // 1: order.Order.Price:type_name -> order.Price
-	4,  // 2: order.Order.StopPrice:type_name -> order.Price
+	8,  // 1: order.Order.Price:type_name -> order.Price
+	8,  // 2: order.Order.StopPrice:type_name -> order.Price
 	0,  // 3: order.Order.Params:type_name -> order.OrderParams
-	4,  // 4: order.SubmitOrderRequest.Price:type_name -> order.Price
-	4,  // 5: order.SubmitOrderRequest.StopPrice:type_name -> order.Price
-	1,  // 6: order.SubmitOrderRequest.Kind:type_name -> order.OrderKind
-	2,  // 7: order.SubmitOrderRequest.Side:type_name -> order.OrderSide
-	0,  // 8: order.SubmitOrderRequest.Params:type_name -> order.OrderParams
-	3,  // 9: order.ListAllAskReply.Orders:type_name -> order.Order
-	3,  // 10: order.ListAllBidsReply.Orders:type_name -> order.Order
-	5,  // 11: order.OrderMatchingService.SubmitOrder:input_type -> order.SubmitOrderRequest
-	7,  // 12: order.OrderMatchingService.ListAllAsks:input_type -> order.ListAllAsksRequest
-	9,  // 13: order.OrderMatchingService.ListAllBids:input_type -> order.ListAllBidsRequest
-	6,  // 14: order.OrderMatchingService.SubmitOrder:output_type -> order.SubmitOrderReply
-	8,  // 15: order.OrderMatchingService.ListAllAsks:output_type -> order.ListAllAskReply
-	10, // 16: order.OrderMatchingService.ListAllBids:output_type -> order.ListAllBidsReply
-	14, // [14:17] is the sub-list for method output_type
-	11, // [11:14] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	3,  // 4: order.Order.Peg:type_name -> order.OrderPeg
+	8,  // 5: order.SubmitOrderRequest.Price:type_name -> order.Price
+	8,  // 6: order.SubmitOrderRequest.StopPrice:type_name -> order.Price
+	1,  // 7: order.SubmitOrderRequest.Kind:type_name -> order.OrderKind
+	2,  // 8: order.SubmitOrderRequest.Side:type_name -> order.OrderSide
+	0,  // 9: order.SubmitOrderRequest.Params:type_name -> order.OrderParams
+	8,  // 10: order.SubmitOrderRequest.TrailAmount:type_name -> order.Price
+	8,  // 11: order.SubmitOrderRequest.TrailPercent:type_name -> order.Price
+	3,  // 12: order.SubmitOrderRequest.Peg:type_name -> order.OrderPeg
+	8,  // 13: order.SubmitOrderRequest.PegOffset:type_name -> order.Price
+	8,  // 14: order.SubmitOrderRequest.PegCap:type_name -> order.Price
+	4,  // 15: order.SubmitOrderRequest.STP:type_name -> order.SelfTradePrevention
+	8,  // 16: order.SubmitOrderRequest.Notional:type_name -> order.Price
+	8,  // 17: order.SubmitOrderReply.Price:type_name -> order.Price
+	6,  // 18: order.SubmitOrderReply.MarketRemainder:type_name -> order.MarketRemainder
+	8,  // 19: order.SubmitOrderReply.FilledNotional:type_name -> order.Price
+	9,  // 20: order.SubmitBracketOrderRequest.Entry:type_name -> order.SubmitOrderRequest
+	8,  // 21: order.SubmitBracketOrderRequest.TakeProfitPrice:type_name -> order.Price
+	8,  // 22: order.SubmitBracketOrderRequest.StopLossStopPrice:type_name -> order.Price
+	8,  // 23: order.SubmitBracketOrderRequest.StopLossPrice:type_name -> order.Price
+	10, // 24: order.SubmitBracketOrderReply.Entry:type_name -> order.SubmitOrderReply
+	8,  // 25: order.ReplaceOrderRequest.Price:type_name -> order.Price
+	8,  // 26: order.ReplaceOrderReply.Price:type_name -> order.Price
+	2,  // 27: order.MassCancelRequest.Side:type_name -> order.OrderSide
+	18, // 28: order.MassCancelReply.Outcomes:type_name -> order.MassCancelOutcome
+	4,  // 29: order.SetSelfTradePreventionRequest.STP:type_name -> order.SelfTradePrevention
+	5,  // 30: order.SymbolTradingPhase.Phase:type_name -> order.TradingPhase
+	29, // 31: order.GetTradingPhaseReply.Phases:type_name -> order.SymbolTradingPhase
+	7,  // 32: order.ListAllAskReply.Orders:type_name -> order.Order
+	7,  // 33: order.ListAllBidsReply.Orders:type_name -> order.Order
+	9,  // 34: order.OrderMatchingService.SubmitOrder:input_type -> order.SubmitOrderRequest
+	11, // 35: order.OrderMatchingService.SubmitBracketOrder:input_type -> order.SubmitBracketOrderRequest
+	13, // 36: order.OrderMatchingService.CancelOrder:input_type -> order.CancelOrderRequest
+	15, // 37: order.OrderMatchingService.ReplaceOrder:input_type -> order.ReplaceOrderRequest
+	17, // 38: order.OrderMatchingService.MassCancel:input_type -> order.MassCancelRequest
+	20, // 39: order.OrderMatchingService.Session:input_type -> order.SessionRequest
+	22, // 40: order.OrderMatchingService.SetSelfTradePrevention:input_type -> order.SetSelfTradePreventionRequest
+	24, // 41: order.OrderMatchingService.HaltTrading:input_type -> order.HaltTradingRequest
+	26, // 42: order.OrderMatchingService.ResumeTrading:input_type -> order.ResumeTradingRequest
+	28, // 43: order.OrderMatchingService.GetTradingPhase:input_type -> order.GetTradingPhaseRequest
+	31, // 44: order.OrderMatchingService.ListAllAsks:input_type -> order.ListAllAsksRequest
+	33, // 45: order.OrderMatchingService.ListAllBids:input_type -> order.ListAllBidsRequest
+	10, // 46: order.OrderMatchingService.SubmitOrder:output_type -> order.SubmitOrderReply
+	12, // 47: order.OrderMatchingService.SubmitBracketOrder:output_type -> order.SubmitBracketOrderReply
+	14, // 48: order.OrderMatchingService.CancelOrder:output_type -> order.CancelOrderReply
+	16, // 49: order.OrderMatchingService.ReplaceOrder:output_type -> order.ReplaceOrderReply
+	19, // 50: order.OrderMatchingService.MassCancel:output_type -> order.MassCancelReply
+	21, // 51: order.OrderMatchingService.Session:output_type -> order.SessionReply
+	23, // 52: order.OrderMatchingService.SetSelfTradePrevention:output_type -> order.SetSelfTradePreventionReply
+	25, // 53: order.OrderMatchingService.HaltTrading:output_type -> order.HaltTradingReply
+	27, // 54: order.OrderMatchingService.ResumeTrading:output_type -> order.ResumeTradingReply
+	30, // 55: order.OrderMatchingService.GetTradingPhase:output_type -> order.GetTradingPhaseReply
+	32, // 56: order.OrderMatchingService.ListAllAsks:output_type -> order.ListAllAskReply
+	34, // 57: order.OrderMatchingService.ListAllBids:output_type -> order.ListAllBidsReply
+	46, // [46:58] is the sub-list for method output_type
+	34, // [34:46] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_order_order_proto_init() }
@@ -898,7 +2943,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAllAsksRequest); i {
+			switch v := v.(*SubmitBracketOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -910,7 +2955,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAllAskReply); i {
+			switch v := v.(*SubmitBracketOrderReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -922,7 +2967,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAllBidsRequest); i {
+			switch v := v.(*CancelOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -934,6 +2979,246 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceOrderReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MassCancelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MassCancelOutcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MassCancelReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSelfTradePreventionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSelfTradePreventionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HaltTradingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HaltTradingReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeTradingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeTradingReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradingPhaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbolTradingPhase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradingPhaseReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllAsksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllAskReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllBidsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAllBidsReply); i {
 			case 0:
 				return &v.state
@@ -951,8 +3236,8 @@ func file_order_order_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_order_order_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   8,
+			NumEnums:      7,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,8 +22,32 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderMatchingServiceClient interface {
+	// Submit order to order matching engine
+	// Trade history will send by MQ when successful matching
 	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderReply, error)
+	// Submit an entry order with take-profit and stop-loss orders which become active once the entry fills
+	SubmitBracketOrder(ctx context.Context, in *SubmitBracketOrderRequest, opts ...grpc.CallOption) (*SubmitBracketOrderReply, error)
+	// Cancel an order and remove it from the order book
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderReply, error)
+	// Amend the quantity or the price of an order
+	// Reducing the quantity keeps the time priority, a price change or a quantity increase loses it
+	ReplaceOrder(ctx context.Context, in *ReplaceOrderRequest, opts ...grpc.CallOption) (*ReplaceOrderReply, error)
+	// Cancel every order matching the filter across all order books
+	MassCancel(ctx context.Context, in *MassCancelRequest, opts ...grpc.CallOption) (*MassCancelReply, error)
+	// Open an order entry session, every request is a heartbeat and is acknowledged by a reply
+	// The customer's orders are cancelled once the stream drops or stops heartbeating
+	Session(ctx context.Context, opts ...grpc.CallOption) (OrderMatchingService_SessionClient, error)
+	// Set the self-trade prevention of the customer's orders which don't set their own
+	SetSelfTradePrevention(ctx context.Context, in *SetSelfTradePreventionRequest, opts ...grpc.CallOption) (*SetSelfTradePreventionReply, error)
+	// Halt trading in the order book, new orders are rejected with FailedPrecondition and cancels are still accepted
+	HaltTrading(ctx context.Context, in *HaltTradingRequest, opts ...grpc.CallOption) (*HaltTradingReply, error)
+	// Resume trading in the halted order book straight away or after the re-opening period
+	ResumeTrading(ctx context.Context, in *ResumeTradingRequest, opts ...grpc.CallOption) (*ResumeTradingReply, error)
+	// Get the trading phase of the order book, or of every order book when the symbol is empty
+	GetTradingPhase(ctx context.Context, in *GetTradingPhaseRequest, opts ...grpc.CallOption) (*GetTradingPhaseReply, error)
+	// List all asks orders include Limit and Market orders
 	ListAllAsks(ctx context.Context, in *ListAllAsksRequest, opts ...grpc.CallOption) (*ListAllAskReply, error)
+	// List all bids orders include Limit and Market orders
 	ListAllBids(ctx context.Context, in *ListAllBidsRequest, opts ...grpc.CallOption) (*ListAllBidsReply, error)
 }
 
@@ -44,6 +68,109 @@ func (c *orderMatchingServiceClient) SubmitOrder(ctx context.Context, in *Submit
 	return out, nil
 }
 
+func (c *orderMatchingServiceClient) SubmitBracketOrder(ctx context.Context, in *SubmitBracketOrderRequest, opts ...grpc.CallOption) (*SubmitBracketOrderReply, error) {
+	out := new(SubmitBracketOrderReply)
+	err := c.cc.Invoke(ctx, "/order.OrderMatchingService/SubmitBracketOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderMatchingServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderReply, error) {
+	out := new(CancelOrderReply)
+	err := c.cc.Invoke(ctx, "/order.OrderMatchingService/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderMatchingServiceClient) ReplaceOrder(ctx context.Context, in *ReplaceOrderRequest, opts ...grpc.CallOption) (*ReplaceOrderReply, error) {
+	out := new(ReplaceOrderReply)
+	err := c.cc.Invoke(ctx, "/order.OrderMatchingService/ReplaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderMatchingServiceClient) MassCancel(ctx context.Context, in *MassCancelRequest, opts ...grpc.CallOption) (*MassCancelReply, error) {
+	out := new(MassCancelReply)
+	err := c.cc.Invoke(ctx, "/order.OrderMatchingService/MassCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderMatchingServiceClient) Session(ctx context.Context, opts ...grpc.CallOption) (OrderMatchingService_SessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrderMatchingService_ServiceDesc.Streams[0], "/order.OrderMatchingService/Session", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderMatchingServiceSessionClient{stream}
+	return x, nil
+}
+
+type OrderMatchingService_SessionClient interface {
+	Send(*SessionRequest) error
+	Recv() (*SessionReply, error)
+	grpc.ClientStream
+}
+
+type orderMatchingServiceSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderMatchingServiceSessionClient) Send(m *SessionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *orderMatchingServiceSessionClient) Recv() (*SessionReply, error) {
+	m := new(SessionReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orderMatchingServiceClient) SetSelfTradePrevention(ctx context.Context, in *SetSelfTradePreventionRequest, opts ...grpc.CallOption) (*SetSelfTradePreventionReply, error) {
+	out := new(SetSelfTradePreventionReply)
+	err := c.cc.Invoke(ctx, "/order.OrderMatchingService/SetSelfTradePrevention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderMatchingServiceClient) HaltTrading(ctx context.Context, in *HaltTradingRequest, opts ...grpc.CallOption) (*HaltTradingReply, error) {
+	out := new(HaltTradingReply)
+	err := c.cc.Invoke(ctx, "/order.OrderMatchingService/HaltTrading", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderMatchingServiceClient) ResumeTrading(ctx context.Context, in *ResumeTradingRequest, opts ...grpc.CallOption) (*ResumeTradingReply, error) {
+	out := new(ResumeTradingReply)
+	err := c.cc.Invoke(ctx, "/order.OrderMatchingService/ResumeTrading", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderMatchingServiceClient) GetTradingPhase(ctx context.Context, in *GetTradingPhaseRequest, opts ...grpc.CallOption) (*GetTradingPhaseReply, error) {
+	out := new(GetTradingPhaseReply)
+	err := c.cc.Invoke(ctx, "/order.OrderMatchingService/GetTradingPhase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderMatchingServiceClient) ListAllAsks(ctx context.Context, in *ListAllAsksRequest, opts ...grpc.CallOption) (*ListAllAskReply, error) {
 	out := new(ListAllAskReply)
 	err := c.cc.Invoke(ctx, "/order.OrderMatchingService/ListAllAsks", in, out, opts...)
@@ -66,8 +193,32 @@ func (c *orderMatchingServiceClient) ListAllBids(ctx context.Context, in *ListAl
 // All implementations should embed UnimplementedOrderMatchingServiceServer
 // for forward compatibility
 type OrderMatchingServiceServer interface {
+	// Submit order to order matching engine
+	// Trade history will send by MQ when successful matching
 	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderReply, error)
+	// Submit an entry order with take-profit and stop-loss orders which become active once the entry fills
+	SubmitBracketOrder(context.Context, *SubmitBracketOrderRequest) (*SubmitBracketOrderReply, error)
+	// Cancel an order and remove it from the order book
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderReply, error)
+	// Amend the quantity or the price of an order
+	// Reducing the quantity keeps the time priority, a price change or a quantity increase loses it
+	ReplaceOrder(context.Context, *ReplaceOrderRequest) (*ReplaceOrderReply, error)
+	// Cancel every order matching the filter across all order books
+	MassCancel(context.Context, *MassCancelRequest) (*MassCancelReply, error)
+	// Open an order entry session, every request is a heartbeat and is acknowledged by a reply
+	// The customer's orders are cancelled once the stream drops or stops heartbeating
+	Session(OrderMatchingService_SessionServer) error
+	// Set the self-trade prevention of the customer's orders which don't set their own
+	SetSelfTradePrevention(context.Context, *SetSelfTradePreventionRequest) (*SetSelfTradePreventionReply, error)
+	// Halt trading in the order book, new orders are rejected with FailedPrecondition and cancels are still accepted
+	HaltTrading(context.Context, *HaltTradingRequest) (*HaltTradingReply, error)
+	// Resume trading in the halted order book straight away or after the re-opening period
+	ResumeTrading(context.Context, *ResumeTradingRequest) (*ResumeTradingReply, error)
+	// Get the trading phase of the order book, or of every order book when the symbol is empty
+	GetTradingPhase(context.Context, *GetTradingPhaseRequest) (*GetTradingPhaseReply, error)
+	// List all asks orders include Limit and Market orders
 	ListAllAsks(context.Context, *ListAllAsksRequest) (*ListAllAskReply, error)
+	// List all bids orders include Limit and Market orders
 	ListAllBids(context.Context, *ListAllBidsRequest) (*ListAllBidsReply, error)
 }
 
@@ -78,6 +229,33 @@ type UnimplementedOrderMatchingServiceServer struct {
 func (UnimplementedOrderMatchingServiceServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
 }
+func (UnimplementedOrderMatchingServiceServer) SubmitBracketOrder(context.Context, *SubmitBracketOrderRequest) (*SubmitBracketOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBracketOrder not implemented")
+}
+func (UnimplementedOrderMatchingServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrderMatchingServiceServer) ReplaceOrder(context.Context, *ReplaceOrderRequest) (*ReplaceOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
+func (UnimplementedOrderMatchingServiceServer) MassCancel(context.Context, *MassCancelRequest) (*MassCancelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MassCancel not implemented")
+}
+func (UnimplementedOrderMatchingServiceServer) Session(OrderMatchingService_SessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method Session not implemented")
+}
+func (UnimplementedOrderMatchingServiceServer) SetSelfTradePrevention(context.Context, *SetSelfTradePreventionRequest) (*SetSelfTradePreventionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSelfTradePrevention not implemented")
+}
+func (UnimplementedOrderMatchingServiceServer) HaltTrading(context.Context, *HaltTradingRequest) (*HaltTradingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HaltTrading not implemented")
+}
+func (UnimplementedOrderMatchingServiceServer) ResumeTrading(context.Context, *ResumeTradingRequest) (*ResumeTradingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTrading not implemented")
+}
+func (UnimplementedOrderMatchingServiceServer) GetTradingPhase(context.Context, *GetTradingPhaseRequest) (*GetTradingPhaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradingPhase not implemented")
+}
 func (UnimplementedOrderMatchingServiceServer) ListAllAsks(context.Context, *ListAllAsksRequest) (*ListAllAskReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllAsks not implemented")
 }
@@ -114,6 +292,176 @@ func _OrderMatchingService_SubmitOrder_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderMatchingService_SubmitBracketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBracketOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderMatchingServiceServer).SubmitBracketOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderMatchingService/SubmitBracketOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderMatchingServiceServer).SubmitBracketOrder(ctx, req.(*SubmitBracketOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderMatchingService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderMatchingServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderMatchingService/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderMatchingServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderMatchingService_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderMatchingServiceServer).ReplaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderMatchingService/ReplaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderMatchingServiceServer).ReplaceOrder(ctx, req.(*ReplaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderMatchingService_MassCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MassCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderMatchingServiceServer).MassCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderMatchingService/MassCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderMatchingServiceServer).MassCancel(ctx, req.(*MassCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderMatchingService_Session_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrderMatchingServiceServer).Session(&orderMatchingServiceSessionServer{stream})
+}
+
+type OrderMatchingService_SessionServer interface {
+	Send(*SessionReply) error
+	Recv() (*SessionRequest, error)
+	grpc.ServerStream
+}
+
+type orderMatchingServiceSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderMatchingServiceSessionServer) Send(m *SessionReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *orderMatchingServiceSessionServer) Recv() (*SessionRequest, error) {
+	m := new(SessionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _OrderMatchingService_SetSelfTradePrevention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSelfTradePreventionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderMatchingServiceServer).SetSelfTradePrevention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderMatchingService/SetSelfTradePrevention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderMatchingServiceServer).SetSelfTradePrevention(ctx, req.(*SetSelfTradePreventionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderMatchingService_HaltTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HaltTradingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderMatchingServiceServer).HaltTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderMatchingService/HaltTrading",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderMatchingServiceServer).HaltTrading(ctx, req.(*HaltTradingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderMatchingService_ResumeTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTradingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderMatchingServiceServer).ResumeTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderMatchingService/ResumeTrading",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderMatchingServiceServer).ResumeTrading(ctx, req.(*ResumeTradingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderMatchingService_GetTradingPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradingPhaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderMatchingServiceServer).GetTradingPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderMatchingService/GetTradingPhase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderMatchingServiceServer).GetTradingPhase(ctx, req.(*GetTradingPhaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderMatchingService_ListAllAsks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAllAsksRequest)
 	if err := dec(in); err != nil {
@@ -161,6 +509,38 @@ var OrderMatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitOrder",
 			Handler:    _OrderMatchingService_SubmitOrder_Handler,
 		},
+		{
+			MethodName: "SubmitBracketOrder",
+			Handler:    _OrderMatchingService_SubmitBracketOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OrderMatchingService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _OrderMatchingService_ReplaceOrder_Handler,
+		},
+		{
+			MethodName: "MassCancel",
+			Handler:    _OrderMatchingService_MassCancel_Handler,
+		},
+		{
+			MethodName: "SetSelfTradePrevention",
+			Handler:    _OrderMatchingService_SetSelfTradePrevention_Handler,
+		},
+		{
+			MethodName: "HaltTrading",
+			Handler:    _OrderMatchingService_HaltTrading_Handler,
+		},
+		{
+			MethodName: "ResumeTrading",
+			Handler:    _OrderMatchingService_ResumeTrading_Handler,
+		},
+		{
+			MethodName: "GetTradingPhase",
+			Handler:    _OrderMatchingService_GetTradingPhase_Handler,
+		},
 		{
 			MethodName: "ListAllAsks",
 			Handler:    _OrderMatchingService_ListAllAsks_Handler,
@@ -170,6 +550,13 @@ var OrderMatchingService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _OrderMatchingService_ListAllBids_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Session",
+			Handler:       _OrderMatchingService_Session_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "order/order.proto",
 }
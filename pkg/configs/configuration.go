@@ -11,8 +11,10 @@ import (
 
 // Configuration are contain all app config
 type Configuration struct {
-	Log  logging.Config `mapstructure:"log"`
-	GRPC GRPCServer     `mapstructure:"grpc"`
+	Log         logging.Config `mapstructure:"log"`
+	GRPC        GRPCServer     `mapstructure:"grpc"`
+	Order       OrderService   `mapstructure:"order"`
+	Instruments []Instrument   `mapstructure:"instruments"`
 }
 
 func ExpandEnvHook(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
@@ -0,0 +1,83 @@
+package configs
+
+// Instrument is define the reference data of an order book, prices are decimal strings
+type Instrument struct {
+	Symbol string `mapstructure:"symbol"`
+	// MarketPrice the price the order book starts from
+	MarketPrice string `mapstructure:"marketPrice"`
+	// TickSize minimum price increment above the last step of the tick ladder
+	TickSize string `mapstructure:"tickSize"`
+	// TickLadder price dependent tick sizes by ascending bound, it can be empty
+	TickLadder []TickLevel `mapstructure:"tickLadder"`
+	LotSize    int64       `mapstructure:"lotSize"`
+	MinQty     int64       `mapstructure:"minQty"`
+	MaxQty     int64       `mapstructure:"maxQty"`
+	// MinNotional and MaxNotional limit the price times quantity of limit orders, they can be empty
+	MinNotional string `mapstructure:"minNotional"`
+	MaxNotional string `mapstructure:"maxNotional"`
+	// PriceBandPercent orders trade within this percent around the reference price, it can be empty
+	PriceBandPercent string `mapstructure:"priceBandPercent"`
+	// PriceBandPolicy how the rest of a market order stopped at the price band is handled: cancel or limit
+	PriceBandPolicy string `mapstructure:"priceBandPolicy"`
+	// ReferencePrice the price band is set around it, e.g. the previous close
+	// the band follows the market price when it is empty
+	ReferencePrice string `mapstructure:"referencePrice"`
+	// MarketPolicy how the rest of a market order which found nothing more to match is handled:
+	// rest, cancel, limit (market-to-limit at the last trade price) or collar
+	MarketPolicy string `mapstructure:"marketPolicy"`
+	// CollarTicks market orders match within these ticks from the best price at arrival by the collar policy
+	CollarTicks int64 `mapstructure:"collarTicks"`
+	// Schedule the trading phases of a day, the order book trades continuously when it is empty
+	Schedule Schedule `mapstructure:"schedule"`
+	// Matching the algorithm incoming orders are allocated among the resting orders of a price level by
+	Matching Matching `mapstructure:"matching"`
+	// Fees the maker and taker fees of the trades, no fees are charged when it is empty
+	Fees Fees `mapstructure:"fees"`
+}
+
+// Fees is define the fee rates of the trade notional, e.g. '0.001' means 0.1%
+// a negative maker rate is a rebate, it can't exceed the taker rate
+type Fees struct {
+	Maker string `mapstructure:"maker"`
+	Taker string `mapstructure:"taker"`
+	// Tiers the customers of a tier trade at its rates instead
+	Tiers []FeeTier `mapstructure:"tiers"`
+	// Scale the fees are rounded to a multiple of it, a power of ten like '0.01', it can be empty
+	Scale string `mapstructure:"scale"`
+}
+
+// FeeTier is define the fee rates of a customer tier
+type FeeTier struct {
+	Name      string   `mapstructure:"name"`
+	Customers []string `mapstructure:"customers"`
+	Maker     string   `mapstructure:"maker"`
+	Taker     string   `mapstructure:"taker"`
+}
+
+// Matching is define the matching algorithm of an order book
+type Matching struct {
+	// Algorithm fifo or pro-rata, fifo when it is empty
+	Algorithm string `mapstructure:"algorithm"`
+	// TopOrder the first order of a price level is filled before the pro-rata allocation
+	TopOrder bool `mapstructure:"topOrder"`
+	// MinAllocation pro-rata shares below are allocated in time priority instead
+	MinAllocation int64 `mapstructure:"minAllocation"`
+	// LMMCustomers the customer IDs of the lead market makers
+	LMMCustomers []string `mapstructure:"lmmCustomers"`
+	// LMMPercent the percentage of every incoming order allocated to the lead market makers first
+	LMMPercent int64 `mapstructure:"lmmPercent"`
+}
+
+// Schedule is define the UTC time of day each trading phase starts at, e.g. '08:30'
+type Schedule struct {
+	PreOpen    string `mapstructure:"preOpen"`
+	Continuous string `mapstructure:"continuous"`
+	Closing    string `mapstructure:"closing"`
+	Closed     string `mapstructure:"closed"`
+}
+
+// TickLevel is define a step of a tick ladder, prices below the bound use its tick size
+type TickLevel struct {
+	Below    string `mapstructure:"below"`
+	TickSize string `mapstructure:"tickSize"`
+}
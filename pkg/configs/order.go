@@ -0,0 +1,9 @@
+package configs
+
+import "time"
+
+// OrderService is define order service config
+type OrderService struct {
+	// ClientOrderIDWindow is how long a client order id is remembered to answer retried submits
+	ClientOrderIDWindow time.Duration `mapstructure:"clientOrderIDWindow"`
+}
@@ -5,8 +5,13 @@ package mocks
 import (
 	context "context"
 
-	order "github.com/karta0898098/mome/pkg/order"
+	apd "github.com/cockroachdb/apd"
+
 	mock "github.com/stretchr/testify/mock"
+
+	order "github.com/karta0898098/mome/pkg/order"
+
+	time "time"
 )
 
 // MockProvider is an autogenerated mock type for the Provider type
@@ -22,13 +27,67 @@ func (_m *MockProvider) EXPECT() *MockProvider_Expecter {
 	return &MockProvider_Expecter{mock: &_m.Mock}
 }
 
-// SubmitOrder provides a mock function with given fields: ctx, _a1
-func (_m *MockProvider) SubmitOrder(ctx context.Context, _a1 order.Order) error {
-	ret := _m.Called(ctx, _a1)
+// CancelOrder provides a mock function with given fields: ctx, symbol, orderID
+func (_m *MockProvider) CancelOrder(ctx context.Context, symbol string, orderID string) (order.Order, error) {
+	ret := _m.Called(ctx, symbol, orderID)
+
+	var r0 order.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (order.Order, error)); ok {
+		return rf(ctx, symbol, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) order.Order); ok {
+		r0 = rf(ctx, symbol, orderID)
+	} else {
+		r0 = ret.Get(0).(order.Order)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, symbol, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvider_CancelOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrder'
+type MockProvider_CancelOrder_Call struct {
+	*mock.Call
+}
+
+// CancelOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - symbol string
+//   - orderID string
+func (_e *MockProvider_Expecter) CancelOrder(ctx interface{}, symbol interface{}, orderID interface{}) *MockProvider_CancelOrder_Call {
+	return &MockProvider_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, symbol, orderID)}
+}
+
+func (_c *MockProvider_CancelOrder_Call) Run(run func(ctx context.Context, symbol string, orderID string)) *MockProvider_CancelOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProvider_CancelOrder_Call) Return(_a0 order.Order, err error) *MockProvider_CancelOrder_Call {
+	_c.Call.Return(_a0, err)
+	return _c
+}
+
+func (_c *MockProvider_CancelOrder_Call) RunAndReturn(run func(context.Context, string, string) (order.Order, error)) *MockProvider_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseSession provides a mock function with given fields: ctx, sessionID
+func (_m *MockProvider) CloseSession(ctx context.Context, sessionID string) error {
+	ret := _m.Called(ctx, sessionID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, order.Order) error); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, sessionID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -36,6 +95,661 @@ func (_m *MockProvider) SubmitOrder(ctx context.Context, _a1 order.Order) error
 	return r0
 }
 
+// MockProvider_CloseSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseSession'
+type MockProvider_CloseSession_Call struct {
+	*mock.Call
+}
+
+// CloseSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+func (_e *MockProvider_Expecter) CloseSession(ctx interface{}, sessionID interface{}) *MockProvider_CloseSession_Call {
+	return &MockProvider_CloseSession_Call{Call: _e.mock.On("CloseSession", ctx, sessionID)}
+}
+
+func (_c *MockProvider_CloseSession_Call) Run(run func(ctx context.Context, sessionID string)) *MockProvider_CloseSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProvider_CloseSession_Call) Return(err error) *MockProvider_CloseSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProvider_CloseSession_Call) RunAndReturn(run func(context.Context, string) error) *MockProvider_CloseSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HaltTrading provides a mock function with given fields: ctx, symbol, reason
+func (_m *MockProvider) HaltTrading(ctx context.Context, symbol string, reason string) error {
+	ret := _m.Called(ctx, symbol, reason)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, symbol, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProvider_HaltTrading_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HaltTrading'
+type MockProvider_HaltTrading_Call struct {
+	*mock.Call
+}
+
+// HaltTrading is a helper method to define mock.On call
+//   - ctx context.Context
+//   - symbol string
+//   - reason string
+func (_e *MockProvider_Expecter) HaltTrading(ctx interface{}, symbol interface{}, reason interface{}) *MockProvider_HaltTrading_Call {
+	return &MockProvider_HaltTrading_Call{Call: _e.mock.On("HaltTrading", ctx, symbol, reason)}
+}
+
+func (_c *MockProvider_HaltTrading_Call) Run(run func(ctx context.Context, symbol string, reason string)) *MockProvider_HaltTrading_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProvider_HaltTrading_Call) Return(err error) *MockProvider_HaltTrading_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProvider_HaltTrading_Call) RunAndReturn(run func(context.Context, string, string) error) *MockProvider_HaltTrading_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Heartbeat provides a mock function with given fields: ctx, sessionID
+func (_m *MockProvider) Heartbeat(ctx context.Context, sessionID string) error {
+	ret := _m.Called(ctx, sessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProvider_Heartbeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Heartbeat'
+type MockProvider_Heartbeat_Call struct {
+	*mock.Call
+}
+
+// Heartbeat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+func (_e *MockProvider_Expecter) Heartbeat(ctx interface{}, sessionID interface{}) *MockProvider_Heartbeat_Call {
+	return &MockProvider_Heartbeat_Call{Call: _e.mock.On("Heartbeat", ctx, sessionID)}
+}
+
+func (_c *MockProvider_Heartbeat_Call) Run(run func(ctx context.Context, sessionID string)) *MockProvider_Heartbeat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProvider_Heartbeat_Call) Return(err error) *MockProvider_Heartbeat_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProvider_Heartbeat_Call) RunAndReturn(run func(context.Context, string) error) *MockProvider_Heartbeat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAllAsks provides a mock function with given fields: ctx, symbol
+func (_m *MockProvider) ListAllAsks(ctx context.Context, symbol string) ([]order.Order, error) {
+	ret := _m.Called(ctx, symbol)
+
+	var r0 []order.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]order.Order, error)); ok {
+		return rf(ctx, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []order.Order); ok {
+		r0 = rf(ctx, symbol)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]order.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvider_ListAllAsks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAllAsks'
+type MockProvider_ListAllAsks_Call struct {
+	*mock.Call
+}
+
+// ListAllAsks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - symbol string
+func (_e *MockProvider_Expecter) ListAllAsks(ctx interface{}, symbol interface{}) *MockProvider_ListAllAsks_Call {
+	return &MockProvider_ListAllAsks_Call{Call: _e.mock.On("ListAllAsks", ctx, symbol)}
+}
+
+func (_c *MockProvider_ListAllAsks_Call) Run(run func(ctx context.Context, symbol string)) *MockProvider_ListAllAsks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProvider_ListAllAsks_Call) Return(orders []order.Order, err error) *MockProvider_ListAllAsks_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *MockProvider_ListAllAsks_Call) RunAndReturn(run func(context.Context, string) ([]order.Order, error)) *MockProvider_ListAllAsks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAllBids provides a mock function with given fields: ctx, symbol
+func (_m *MockProvider) ListAllBids(ctx context.Context, symbol string) ([]order.Order, error) {
+	ret := _m.Called(ctx, symbol)
+
+	var r0 []order.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]order.Order, error)); ok {
+		return rf(ctx, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []order.Order); ok {
+		r0 = rf(ctx, symbol)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]order.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvider_ListAllBids_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAllBids'
+type MockProvider_ListAllBids_Call struct {
+	*mock.Call
+}
+
+// ListAllBids is a helper method to define mock.On call
+//   - ctx context.Context
+//   - symbol string
+func (_e *MockProvider_Expecter) ListAllBids(ctx interface{}, symbol interface{}) *MockProvider_ListAllBids_Call {
+	return &MockProvider_ListAllBids_Call{Call: _e.mock.On("ListAllBids", ctx, symbol)}
+}
+
+func (_c *MockProvider_ListAllBids_Call) Run(run func(ctx context.Context, symbol string)) *MockProvider_ListAllBids_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProvider_ListAllBids_Call) Return(orders []order.Order, err error) *MockProvider_ListAllBids_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *MockProvider_ListAllBids_Call) RunAndReturn(run func(context.Context, string) ([]order.Order, error)) *MockProvider_ListAllBids_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MassCancel provides a mock function with given fields: ctx, filter
+func (_m *MockProvider) MassCancel(ctx context.Context, filter order.CancelFilter) ([]order.CancelResult, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []order.CancelResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, order.CancelFilter) ([]order.CancelResult, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, order.CancelFilter) []order.CancelResult); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]order.CancelResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, order.CancelFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvider_MassCancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MassCancel'
+type MockProvider_MassCancel_Call struct {
+	*mock.Call
+}
+
+// MassCancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter order.CancelFilter
+func (_e *MockProvider_Expecter) MassCancel(ctx interface{}, filter interface{}) *MockProvider_MassCancel_Call {
+	return &MockProvider_MassCancel_Call{Call: _e.mock.On("MassCancel", ctx, filter)}
+}
+
+func (_c *MockProvider_MassCancel_Call) Run(run func(ctx context.Context, filter order.CancelFilter)) *MockProvider_MassCancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(order.CancelFilter))
+	})
+	return _c
+}
+
+func (_c *MockProvider_MassCancel_Call) Return(results []order.CancelResult, err error) *MockProvider_MassCancel_Call {
+	_c.Call.Return(results, err)
+	return _c
+}
+
+func (_c *MockProvider_MassCancel_Call) RunAndReturn(run func(context.Context, order.CancelFilter) ([]order.CancelResult, error)) *MockProvider_MassCancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenSession provides a mock function with given fields: ctx, customerID, timeout
+func (_m *MockProvider) OpenSession(ctx context.Context, customerID string, timeout time.Duration) (string, error) {
+	ret := _m.Called(ctx, customerID, timeout)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (string, error)); ok {
+		return rf(ctx, customerID, timeout)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) string); ok {
+		r0 = rf(ctx, customerID, timeout)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, customerID, timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvider_OpenSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenSession'
+type MockProvider_OpenSession_Call struct {
+	*mock.Call
+}
+
+// OpenSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID string
+//   - timeout time.Duration
+func (_e *MockProvider_Expecter) OpenSession(ctx interface{}, customerID interface{}, timeout interface{}) *MockProvider_OpenSession_Call {
+	return &MockProvider_OpenSession_Call{Call: _e.mock.On("OpenSession", ctx, customerID, timeout)}
+}
+
+func (_c *MockProvider_OpenSession_Call) Run(run func(ctx context.Context, customerID string, timeout time.Duration)) *MockProvider_OpenSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockProvider_OpenSession_Call) Return(sessionID string, err error) *MockProvider_OpenSession_Call {
+	_c.Call.Return(sessionID, err)
+	return _c
+}
+
+func (_c *MockProvider_OpenSession_Call) RunAndReturn(run func(context.Context, string, time.Duration) (string, error)) *MockProvider_OpenSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceOrder provides a mock function with given fields: ctx, symbol, orderID, qty, price
+func (_m *MockProvider) ReplaceOrder(ctx context.Context, symbol string, orderID string, qty int64, price apd.Decimal) (order.ExecutionReport, error) {
+	ret := _m.Called(ctx, symbol, orderID, qty, price)
+
+	var r0 order.ExecutionReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, apd.Decimal) (order.ExecutionReport, error)); ok {
+		return rf(ctx, symbol, orderID, qty, price)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, apd.Decimal) order.ExecutionReport); ok {
+		r0 = rf(ctx, symbol, orderID, qty, price)
+	} else {
+		r0 = ret.Get(0).(order.ExecutionReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, apd.Decimal) error); ok {
+		r1 = rf(ctx, symbol, orderID, qty, price)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvider_ReplaceOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceOrder'
+type MockProvider_ReplaceOrder_Call struct {
+	*mock.Call
+}
+
+// ReplaceOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - symbol string
+//   - orderID string
+//   - qty int64
+//   - price apd.Decimal
+func (_e *MockProvider_Expecter) ReplaceOrder(ctx interface{}, symbol interface{}, orderID interface{}, qty interface{}, price interface{}) *MockProvider_ReplaceOrder_Call {
+	return &MockProvider_ReplaceOrder_Call{Call: _e.mock.On("ReplaceOrder", ctx, symbol, orderID, qty, price)}
+}
+
+func (_c *MockProvider_ReplaceOrder_Call) Run(run func(ctx context.Context, symbol string, orderID string, qty int64, price apd.Decimal)) *MockProvider_ReplaceOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64), args[4].(apd.Decimal))
+	})
+	return _c
+}
+
+func (_c *MockProvider_ReplaceOrder_Call) Return(report order.ExecutionReport, err error) *MockProvider_ReplaceOrder_Call {
+	_c.Call.Return(report, err)
+	return _c
+}
+
+func (_c *MockProvider_ReplaceOrder_Call) RunAndReturn(run func(context.Context, string, string, int64, apd.Decimal) (order.ExecutionReport, error)) *MockProvider_ReplaceOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveClientOrderID provides a mock function with given fields: ctx, symbol, customerID, clientOrderID
+func (_m *MockProvider) ResolveClientOrderID(ctx context.Context, symbol string, customerID string, clientOrderID string) (string, error) {
+	ret := _m.Called(ctx, symbol, customerID, clientOrderID)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return rf(ctx, symbol, customerID, clientOrderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, symbol, customerID, clientOrderID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, symbol, customerID, clientOrderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvider_ResolveClientOrderID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveClientOrderID'
+type MockProvider_ResolveClientOrderID_Call struct {
+	*mock.Call
+}
+
+// ResolveClientOrderID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - symbol string
+//   - customerID string
+//   - clientOrderID string
+func (_e *MockProvider_Expecter) ResolveClientOrderID(ctx interface{}, symbol interface{}, customerID interface{}, clientOrderID interface{}) *MockProvider_ResolveClientOrderID_Call {
+	return &MockProvider_ResolveClientOrderID_Call{Call: _e.mock.On("ResolveClientOrderID", ctx, symbol, customerID, clientOrderID)}
+}
+
+func (_c *MockProvider_ResolveClientOrderID_Call) Run(run func(ctx context.Context, symbol string, customerID string, clientOrderID string)) *MockProvider_ResolveClientOrderID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockProvider_ResolveClientOrderID_Call) Return(orderID string, err error) *MockProvider_ResolveClientOrderID_Call {
+	_c.Call.Return(orderID, err)
+	return _c
+}
+
+func (_c *MockProvider_ResolveClientOrderID_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *MockProvider_ResolveClientOrderID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeTrading provides a mock function with given fields: ctx, symbol, reopenAfter
+func (_m *MockProvider) ResumeTrading(ctx context.Context, symbol string, reopenAfter time.Duration) (order.TradingStatus, error) {
+	ret := _m.Called(ctx, symbol, reopenAfter)
+
+	var r0 order.TradingStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (order.TradingStatus, error)); ok {
+		return rf(ctx, symbol, reopenAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) order.TradingStatus); ok {
+		r0 = rf(ctx, symbol, reopenAfter)
+	} else {
+		r0 = ret.Get(0).(order.TradingStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, symbol, reopenAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvider_ResumeTrading_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeTrading'
+type MockProvider_ResumeTrading_Call struct {
+	*mock.Call
+}
+
+// ResumeTrading is a helper method to define mock.On call
+//   - ctx context.Context
+//   - symbol string
+//   - reopenAfter time.Duration
+func (_e *MockProvider_Expecter) ResumeTrading(ctx interface{}, symbol interface{}, reopenAfter interface{}) *MockProvider_ResumeTrading_Call {
+	return &MockProvider_ResumeTrading_Call{Call: _e.mock.On("ResumeTrading", ctx, symbol, reopenAfter)}
+}
+
+func (_c *MockProvider_ResumeTrading_Call) Run(run func(ctx context.Context, symbol string, reopenAfter time.Duration)) *MockProvider_ResumeTrading_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockProvider_ResumeTrading_Call) Return(status order.TradingStatus, err error) *MockProvider_ResumeTrading_Call {
+	_c.Call.Return(status, err)
+	return _c
+}
+
+func (_c *MockProvider_ResumeTrading_Call) RunAndReturn(run func(context.Context, string, time.Duration) (order.TradingStatus, error)) *MockProvider_ResumeTrading_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSelfTradePrevention provides a mock function with given fields: ctx, customerID, mode, groupID
+func (_m *MockProvider) SetSelfTradePrevention(ctx context.Context, customerID string, mode order.STPMode, groupID string) error {
+	ret := _m.Called(ctx, customerID, mode, groupID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, order.STPMode, string) error); ok {
+		r0 = rf(ctx, customerID, mode, groupID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProvider_SetSelfTradePrevention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSelfTradePrevention'
+type MockProvider_SetSelfTradePrevention_Call struct {
+	*mock.Call
+}
+
+// SetSelfTradePrevention is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customerID string
+//   - mode order.STPMode
+//   - groupID string
+func (_e *MockProvider_Expecter) SetSelfTradePrevention(ctx interface{}, customerID interface{}, mode interface{}, groupID interface{}) *MockProvider_SetSelfTradePrevention_Call {
+	return &MockProvider_SetSelfTradePrevention_Call{Call: _e.mock.On("SetSelfTradePrevention", ctx, customerID, mode, groupID)}
+}
+
+func (_c *MockProvider_SetSelfTradePrevention_Call) Run(run func(ctx context.Context, customerID string, mode order.STPMode, groupID string)) *MockProvider_SetSelfTradePrevention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(order.STPMode), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockProvider_SetSelfTradePrevention_Call) Return(err error) *MockProvider_SetSelfTradePrevention_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProvider_SetSelfTradePrevention_Call) RunAndReturn(run func(context.Context, string, order.STPMode, string) error) *MockProvider_SetSelfTradePrevention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *MockProvider) Start(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// MockProvider_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockProvider_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProvider_Expecter) Start(ctx interface{}) *MockProvider_Start_Call {
+	return &MockProvider_Start_Call{Call: _e.mock.On("Start", ctx)}
+}
+
+func (_c *MockProvider_Start_Call) Run(run func(ctx context.Context)) *MockProvider_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProvider_Start_Call) Return() *MockProvider_Start_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProvider_Start_Call) RunAndReturn(run func(context.Context)) *MockProvider_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitBracketOrder provides a mock function with given fields: ctx, bracket
+func (_m *MockProvider) SubmitBracketOrder(ctx context.Context, bracket order.Bracket) (order.ExecutionReport, error) {
+	ret := _m.Called(ctx, bracket)
+
+	var r0 order.ExecutionReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, order.Bracket) (order.ExecutionReport, error)); ok {
+		return rf(ctx, bracket)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, order.Bracket) order.ExecutionReport); ok {
+		r0 = rf(ctx, bracket)
+	} else {
+		r0 = ret.Get(0).(order.ExecutionReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, order.Bracket) error); ok {
+		r1 = rf(ctx, bracket)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvider_SubmitBracketOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitBracketOrder'
+type MockProvider_SubmitBracketOrder_Call struct {
+	*mock.Call
+}
+
+// SubmitBracketOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bracket order.Bracket
+func (_e *MockProvider_Expecter) SubmitBracketOrder(ctx interface{}, bracket interface{}) *MockProvider_SubmitBracketOrder_Call {
+	return &MockProvider_SubmitBracketOrder_Call{Call: _e.mock.On("SubmitBracketOrder", ctx, bracket)}
+}
+
+func (_c *MockProvider_SubmitBracketOrder_Call) Run(run func(ctx context.Context, bracket order.Bracket)) *MockProvider_SubmitBracketOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(order.Bracket))
+	})
+	return _c
+}
+
+func (_c *MockProvider_SubmitBracketOrder_Call) Return(report order.ExecutionReport, err error) *MockProvider_SubmitBracketOrder_Call {
+	_c.Call.Return(report, err)
+	return _c
+}
+
+func (_c *MockProvider_SubmitBracketOrder_Call) RunAndReturn(run func(context.Context, order.Bracket) (order.ExecutionReport, error)) *MockProvider_SubmitBracketOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitOrder provides a mock function with given fields: ctx, _a1
+func (_m *MockProvider) SubmitOrder(ctx context.Context, _a1 order.Order) (order.ExecutionReport, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 order.ExecutionReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, order.Order) (order.ExecutionReport, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, order.Order) order.ExecutionReport); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(order.ExecutionReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, order.Order) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MockProvider_SubmitOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitOrder'
 type MockProvider_SubmitOrder_Call struct {
 	*mock.Call
@@ -55,12 +769,67 @@ func (_c *MockProvider_SubmitOrder_Call) Run(run func(ctx context.Context, _a1 o
 	return _c
 }
 
-func (_c *MockProvider_SubmitOrder_Call) Return(err error) *MockProvider_SubmitOrder_Call {
-	_c.Call.Return(err)
+func (_c *MockProvider_SubmitOrder_Call) Return(report order.ExecutionReport, err error) *MockProvider_SubmitOrder_Call {
+	_c.Call.Return(report, err)
+	return _c
+}
+
+func (_c *MockProvider_SubmitOrder_Call) RunAndReturn(run func(context.Context, order.Order) (order.ExecutionReport, error)) *MockProvider_SubmitOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TradingPhases provides a mock function with given fields: ctx, symbol
+func (_m *MockProvider) TradingPhases(ctx context.Context, symbol string) ([]order.PhaseStatus, error) {
+	ret := _m.Called(ctx, symbol)
+
+	var r0 []order.PhaseStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]order.PhaseStatus, error)); ok {
+		return rf(ctx, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []order.PhaseStatus); ok {
+		r0 = rf(ctx, symbol)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]order.PhaseStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProvider_TradingPhases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradingPhases'
+type MockProvider_TradingPhases_Call struct {
+	*mock.Call
+}
+
+// TradingPhases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - symbol string
+func (_e *MockProvider_Expecter) TradingPhases(ctx interface{}, symbol interface{}) *MockProvider_TradingPhases_Call {
+	return &MockProvider_TradingPhases_Call{Call: _e.mock.On("TradingPhases", ctx, symbol)}
+}
+
+func (_c *MockProvider_TradingPhases_Call) Run(run func(ctx context.Context, symbol string)) *MockProvider_TradingPhases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProvider_TradingPhases_Call) Return(phases []order.PhaseStatus, err error) *MockProvider_TradingPhases_Call {
+	_c.Call.Return(phases, err)
 	return _c
 }
 
-func (_c *MockProvider_SubmitOrder_Call) RunAndReturn(run func(context.Context, order.Order) error) *MockProvider_SubmitOrder_Call {
+func (_c *MockProvider_TradingPhases_Call) RunAndReturn(run func(context.Context, string) ([]order.PhaseStatus, error)) *MockProvider_TradingPhases_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -8,6 +8,9 @@ import (
 	"github.com/rs/xid"
 )
 
+// decimalContext is used for the price arithmetic of the order book
+var decimalContext = apd.BaseContext.WithPrecision(16)
+
 type Kind int8
 
 const (
@@ -26,16 +29,19 @@ func (o Kind) String() string {
 	}
 }
 
-type Condition int8
+type Condition int16
 
 const (
-	ConditionStop Condition = 0x1                         // stop order (has to have stop price set)
-	ConditionAON  Condition = 0x2                         // all-or-nothing - complete fill or cancel
-	ConditionIOC  Condition = 0x4                         // immediate-or-cancel - immediately fill what you can, cancel the rest
-	ConditionFOK  Condition = ConditionIOC | ConditionAON // immediately try to fill the whole order
-	ConditionGTC  Condition = 0x10                        // good-till-cancelled -  keep order active until manually cancelled
-	ConditionGFD  Condition = 0x20                        // good-for-day keep order active until the end of the trading day
-	ConditionGTD  Condition = 0x40                        // good-till-date - keep order active until the provided date (including the date)
+	ConditionStop         Condition = 0x1                               // stop order (has to have stop price set)
+	ConditionAON          Condition = 0x2                               // all-or-nothing - complete fill or cancel
+	ConditionIOC          Condition = 0x4                               // immediate-or-cancel - immediately fill what you can, cancel the rest
+	ConditionFOK          Condition = ConditionIOC | ConditionAON       // immediately try to fill the whole order
+	ConditionTrailing     Condition = 0x8                               // trailing - the stop price follows the market price by a trail amount or percent
+	ConditionTrailingStop Condition = ConditionStop | ConditionTrailing // stop order with a trailing stop price (has to have trail set)
+	ConditionGTC          Condition = 0x10                              // good-till-cancelled -  keep order active until manually cancelled
+	ConditionGFD          Condition = 0x20                              // good-for-day keep order active until the end of the trading day
+	ConditionGTD          Condition = 0x40                              // good-till-date - keep order active until the provided date (including the date)
+	ConditionPostOnly     Condition = 0x80                              // post-only - the order never takes liquidity, it is rejected or repriced instead
 )
 
 func (o Condition) appendStr(hasPrefix bool, sb *strings.Builder, param Condition, value string) bool {
@@ -52,12 +58,20 @@ func (o Condition) appendStr(hasPrefix bool, sb *strings.Builder, param Conditio
 func (o Condition) String() string {
 	var sb strings.Builder
 	added := false
-	added = o.appendStr(added, &sb, ConditionStop, "STOP")
+	if o.Is(ConditionTrailingStop) {
+		added = o.appendStr(added, &sb, ConditionTrailingStop, "TRAILING_STOP")
+	} else {
+		added = o.appendStr(added, &sb, ConditionStop, "STOP")
+	}
 	added = o.appendStr(added, &sb, ConditionFOK, "FOK")
 	if !o.Is(ConditionFOK) {
 		added = o.appendStr(added, &sb, ConditionAON, "AON")
 		added = o.appendStr(added, &sb, ConditionIOC, "IOC")
 	}
+	added = o.appendStr(added, &sb, ConditionGTC, "GTC")
+	added = o.appendStr(added, &sb, ConditionGFD, "GFD")
+	added = o.appendStr(added, &sb, ConditionGTD, "GTD")
+	added = o.appendStr(added, &sb, ConditionPostOnly, "POST_ONLY")
 	return sb.String()
 }
 
@@ -70,7 +84,9 @@ func (o Condition) Is(param Condition) bool {
 type Side uint
 
 const (
-	SideBuy Side = iota + 1
+	// SideNone is no side, e.g. the aggressor of an auction trade
+	SideNone Side = iota
+	SideBuy
 	SideSell
 )
 
@@ -82,15 +98,32 @@ type Order struct {
 	CreatedAt time.Time
 	// the customer id
 	CustomerID string
+	// the order id given by the customer, unique among the customer's orders
+	ClientOrderID string
 
-	Kind      Kind      // order kind - market or limit
-	Params    Condition // order parameters which change the way an order is stored and matched
-	Qty       int64
-	FilledQty int64       // currently filled quantity
-	Price     apd.Decimal // used in limit orders
-	StopPrice apd.Decimal // used in stop orders
-	Side      Side        // determines whether an order is a bid (buy) or an ask (sell)
-	Cancelled bool        // determines if an order is cancelled. A partially filled order can be cancelled.
+	Kind           Kind      // order kind - market or limit
+	Params         Condition // order parameters which change the way an order is stored and matched
+	Qty            int64
+	FilledQty      int64        // currently filled quantity
+	Notional       apd.Decimal  // used in notional orders, the amount to buy or sell for instead of a quantity
+	FilledNotional apd.Decimal  // the executed price times quantity
+	DisplayQty     int64        // used in iceberg orders, the size of a tranche shown in the books
+	VisibleQty     int64        // used in iceberg orders, the quantity currently shown in the books
+	Price          apd.Decimal  // used in limit orders
+	StopPrice      apd.Decimal  // used in stop orders
+	TrailAmount    apd.Decimal  // used in trailing stop orders, distance of the stop price from the best market price
+	TrailPercent   apd.Decimal  // used in trailing stop orders, distance in percent of the best market price
+	PostOnlySlide  bool         // used in post-only orders, reprice instead of reject an order which would take liquidity
+	Peg            PegReference // used in pegged orders, the price of the books the order price follows
+	PegOffset      apd.Decimal  // used in pegged orders, added to the followed price
+	PegCap         apd.Decimal  // used in pegged orders, the order is never priced through this limit
+	OCOGroupID     string       // one-cancels-other group, a fill of any order of the group cancels the others
+	STPMode        STPMode      // self-trade prevention applied when the order would trade with the same customer
+	STPGroupID     string       // self-trade prevention group, orders of related customers sharing it never trade
+	Side           Side         // determines whether an order is a bid (buy) or an ask (sell)
+	Cancelled      bool         // determines if an order is cancelled. A partially filled order can be cancelled.
+	ExpireAt       time.Time    // used in good-till-date and good-for-day orders, the order is expired after this time
+	Expired        bool         // determines if an order was expired by its time in force before it was filled
 }
 
 func NewOrder(
@@ -123,10 +156,24 @@ func (o *Order) IsCancelled() bool {
 	return o.Cancelled
 }
 
+func (o *Order) IsExpired() bool {
+	return o.Expired
+}
+
 func (o *Order) IsFilled() bool {
 	return o.Qty-o.FilledQty == 0
 }
 
+// IsStopMarket returns true for a stop order which crosses the spread once triggered.
+func (o *Order) IsStopMarket() bool {
+	return o.Params.Is(ConditionStop) && o.Kind == KindMarket
+}
+
+// IsStopLimit returns true for a stop order which becomes a limit order at its price once triggered.
+func (o *Order) IsStopLimit() bool {
+	return o.Params.Is(ConditionStop) && o.Kind == KindLimit
+}
+
 func (o *Order) IsBid() bool {
 	return o.Side == SideBuy
 }
@@ -139,14 +186,89 @@ func (o *Order) Cancel() {
 	o.Cancelled = true
 }
 
+func (o *Order) Expire() {
+	o.Expired = true
+}
+
+// HasExpiry returns true if the order time in force requires it to be expired by the book.
+func (o *Order) HasExpiry() bool {
+	return o.Params.Is(ConditionGFD) || o.Params.Is(ConditionGTD)
+}
+
 func (o *Order) UnfilledQty() int64 {
 	return o.Qty - o.FilledQty
 }
 
+// IsNotional returns true if the quantity of the order follows from the amount to buy or sell for.
+func (o *Order) IsNotional() bool {
+	return !o.Notional.IsZero()
+}
+
+// IsIceberg returns true if only a tranche of the order is shown in the books.
+func (o *Order) IsIceberg() bool {
+	return o.DisplayQty > 0
+}
+
+// Replenish shows the next tranche of an iceberg order.
+func (o *Order) Replenish() {
+	o.VisibleQty = min(o.DisplayQty, o.UnfilledQty())
+}
+
+// fillVisible takes the filled quantity from the displayed tranche of an iceberg order.
+// Returns true if the tranche was used up and the next one was replenished.
+func (o *Order) fillVisible(qty int64) bool {
+	o.VisibleQty -= min(qty, o.VisibleQty)
+	if o.VisibleQty > 0 || o.IsFilled() {
+		return false
+	}
+	o.Replenish()
+	return true
+}
+
+// Displayed returns the order as it is shown in the books, an iceberg order shows only its displayed tranche.
+func (o *Order) Displayed() Order {
+	displayed := *o
+	if o.IsIceberg() {
+		displayed.Qty = o.FilledQty + o.VisibleQty
+	}
+	return displayed
+}
+
 type OrderTracker struct {
 	ID        string
 	Kind      Kind
-	Price     float64
+	Price     apd.Decimal // the price of an order in the books, the stop price of a stop order
 	Side      Side
-	Timestamp int64 // nanoseconds since Epoch
+	Timestamp int64  // nanoseconds since Epoch
+	Sequence  uint64 // the arrival sequence of the order book, breaks the ties of equal timestamps
+}
+
+// before returns true when the tracker arrived before the other one
+func (t OrderTracker) before(other OrderTracker) bool {
+	if t.Timestamp != other.Timestamp {
+		return t.Timestamp < other.Timestamp
+	}
+	return t.Sequence < other.Sequence
+}
+
+// newOrderTracker creates a tracker which sorts the order by its price in the books.
+func newOrderTracker(order Order) OrderTracker {
+	return OrderTracker{
+		ID:        order.ID,
+		Kind:      order.Kind,
+		Price:     order.Price,
+		Side:      order.Side,
+		Timestamp: order.CreatedAt.UnixNano(),
+	}
+}
+
+// newStopTracker creates a tracker which sorts the order by its stop price in the stop orders.
+func newStopTracker(order Order) OrderTracker {
+	return OrderTracker{
+		ID:        order.ID,
+		Kind:      order.Kind,
+		Price:     order.StopPrice,
+		Side:      order.Side,
+		Timestamp: order.CreatedAt.UnixNano(),
+	}
 }
@@ -0,0 +1,326 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/apd"
+	"github.com/google/uuid"
+)
+
+// AuctionResult is the uncrossing of the orders collected for a call auction
+type AuctionResult struct {
+	Price     apd.Decimal // every crossing order is executed at this price
+	Volume    int64       // the executable quantity
+	Imbalance int64       // the surplus of the bids at the price, negative for a surplus of the asks
+}
+
+// IndicativeAuction returns the price and the volume the collected orders would uncross at now,
+// ok is false when they don't cross.
+func (o *OrderBook) IndicativeAuction() (result AuctionResult, ok bool, err error) {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+
+	bids, asks := o.auctionOrders()
+	return o.auctionPrice(bids, asks)
+}
+
+// auctionOrders returns the bids and the asks taking part in an auction in their priority,
+// all-or-nothing orders only trade in continuous trading.
+func (o *OrderBook) auctionOrders() (bids, asks []Order) {
+	o.orderMutex.RLock()
+	defer o.orderMutex.RUnlock()
+
+	collect := func(side Side) []Order {
+		orders := make([]Order, 0, o.orders.Len(side))
+		for iter := o.orders.Iterator(side); iter.Valid(); iter.Next() {
+			order, ok := o.activeOrders[iter.Key().ID]
+			if !ok || order.IsCancelled() || order.Params.Is(ConditionAON) {
+				continue
+			}
+			orders = append(orders, order)
+		}
+		return orders
+	}
+	return collect(SideBuy), collect(SideSell)
+}
+
+// crosses returns true when the order is executable at the auction price
+func crosses(order *Order, price *apd.Decimal) bool {
+	if order.Kind == KindMarket {
+		return true
+	}
+	if order.IsBid() {
+		return order.Price.Cmp(price) >= 0
+	}
+	return order.Price.Cmp(price) <= 0
+}
+
+// executableQty returns the quantity of the orders executable at the auction price
+func executableQty(orders []Order, price *apd.Decimal) int64 {
+	var qty int64
+	for i := range orders {
+		if crosses(&orders[i], price) {
+			qty += orders[i].UnfilledQty() // the hidden quantity of iceberg orders takes part too
+		}
+	}
+	return qty
+}
+
+// auctionPrice returns the price within the price band which maximizes the executable volume. Ties are broken by
+// the minimum imbalance, then by the market pressure, the highest price for a surplus of the bids and the lowest
+// price for a surplus of the asks, and last by the price closest to the reference price. Market orders crossing only
+// each other are executed at the reference price.
+func (o *OrderBook) auctionPrice(bids, asks []Order) (AuctionResult, bool, error) {
+	bandLow, bandHigh, banded, err := o.priceBand()
+	if err != nil {
+		return AuctionResult{}, false, err
+	}
+	reference := o.ReferencePrice()
+
+	candidates := make([]apd.Decimal, 0, len(bids)+len(asks))
+	for _, orders := range [][]Order{bids, asks} {
+		for _, order := range orders {
+			if order.Kind == KindLimit {
+				candidates = append(candidates, order.Price)
+			}
+		}
+	}
+	if len(candidates) == 0 && reference.Sign() > 0 {
+		candidates = append(candidates, reference)
+	}
+
+	results := make([]AuctionResult, 0, len(candidates))
+	for _, price := range candidates {
+		price := price
+		if banded && (price.Cmp(&bandLow) < 0 || price.Cmp(&bandHigh) > 0) {
+			continue
+		}
+		bidQty, askQty := executableQty(bids, &price), executableQty(asks, &price)
+		if volume := min(bidQty, askQty); volume > 0 {
+			results = append(results, AuctionResult{Price: price, Volume: volume, Imbalance: bidQty - askQty})
+		}
+	}
+	if len(results) == 0 {
+		return AuctionResult{}, false, nil
+	}
+
+	// the maximum volume with the minimum imbalance, the price ascends for the market pressure and the reference price
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Volume != results[j].Volume {
+			return results[i].Volume > results[j].Volume
+		}
+		if a, b := abs(results[i].Imbalance), abs(results[j].Imbalance); a != b {
+			return a < b
+		}
+		return results[i].Price.Cmp(&results[j].Price) < 0
+	})
+	best := results[:1]
+	for _, result := range results[1:] {
+		if result.Volume != best[0].Volume || abs(result.Imbalance) != abs(best[0].Imbalance) {
+			break
+		}
+		if result.Price.Cmp(&best[len(best)-1].Price) != 0 {
+			best = append(best, result)
+		}
+	}
+	if len(best) == 1 {
+		return best[0], true, nil
+	}
+
+	buyPressure, sellPressure := true, true
+	for _, result := range best {
+		buyPressure = buyPressure && result.Imbalance > 0
+		sellPressure = sellPressure && result.Imbalance < 0
+	}
+	switch {
+	case buyPressure:
+		return best[len(best)-1], true, nil
+	case sellPressure:
+		return best[0], true, nil
+	}
+
+	// the price closest to the reference price, the lower one of two equally close prices
+	closest, closestDistance := 0, apd.Decimal{}
+	for i := range best {
+		var distance apd.Decimal
+		if _, err := decimalContext.Sub(&distance, &best[i].Price, &reference); err != nil {
+			return AuctionResult{}, false, err
+		}
+		distance.Abs(&distance)
+		if i == 0 || distance.Cmp(&closestDistance) < 0 {
+			closest, closestDistance = i, distance
+		}
+	}
+	return best[closest], true, nil
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// auction uncrosses the orders collected without matching. The crossing orders are executed at the auction price
+// in their priority, market orders which aren't executed are cancelled. Orders which would trade with the same
+// customer or STP group are handled by the self-trade prevention of the newer one. o.matchMutex has to be held.
+func (o *OrderBook) auction(ctx context.Context) error {
+	o.indicative = nil
+	bids, asks := o.auctionOrders()
+	result, ok, err := o.auctionPrice(bids, asks)
+	if err != nil || !ok {
+		return err
+	}
+
+	var (
+		run       matchRun
+		fills     = make(map[string]int64)
+		prevented = make(map[string]bool) // orders changed by the self-trade prevention
+		executed  = make([]Order, 0)
+		timestamp = time.Now()
+	)
+	executable := func(order *Order) bool {
+		return crosses(order, &result.Price) && order.UnfilledQty() > 0 && !order.IsCancelled()
+	}
+	bid, ask := 0, 0
+	for volume := result.Volume; volume > 0; {
+		for bid < len(bids) && !executable(&bids[bid]) {
+			bid++
+		}
+		for ask < len(asks) && !executable(&asks[ask]) {
+			ask++
+		}
+		if bid == len(bids) || ask == len(asks) {
+			break // the self-trade prevention left less to execute
+		}
+
+		// the self-trade prevention mode of the newer order applies, the same way as for an incoming order
+		newest, oldest := &bids[bid], &asks[ask]
+		if oldest.CreatedAt.After(newest.CreatedAt) {
+			newest, oldest = oldest, newest
+		}
+		if newest.STPMode != STPNone && isSelfTrade(newest, oldest) {
+			o.preventSelfTrade(ctx, newest, oldest, &run)
+			prevented[newest.ID], prevented[oldest.ID] = true, true
+			continue
+		}
+
+		qty := min(bids[bid].UnfilledQty(), asks[ask].UnfilledQty(), volume)
+		total, err := fillNotional(&result.Price, qty, &bids[bid], &asks[ask])
+		if err != nil {
+			return err
+		}
+		buyerFee, sellerFee, err := o.instrument.Fees.fees(bids[bid].CustomerID, asks[ask].CustomerID, SideNone, &total)
+		if err != nil {
+			return err
+		}
+		bids[bid].FilledQty += qty
+		asks[ask].FilledQty += qty
+		fills[bids[bid].ID] += qty
+		fills[asks[ask].ID] += qty
+		volume -= qty
+
+		o.TradeEvents <- EventTradeSuccess{
+			ID:           uuid.New().String(),
+			Buyer:        bids[bid].CustomerID,
+			Seller:       asks[ask].CustomerID,
+			TickerSymbol: o.TickerSymbol,
+			Qty:          qty,
+			Price:        result.Price,
+			Total:        total,
+			Timestamp:    timestamp,
+			BidOrderID:   bids[bid].ID,
+			AskOrderID:   asks[ask].ID,
+			BuyerFee:     buyerFee,
+			SellerFee:    sellerFee,
+			Auction:      true,
+		}
+	}
+	for _, orders := range [][]Order{bids, asks} {
+		for _, order := range orders {
+			if fills[order.ID] > 0 || order.Kind == KindMarket || prevented[order.ID] {
+				executed = append(executed, order)
+			}
+		}
+	}
+
+	for _, order := range executed {
+		order := order
+		qty := fills[order.ID]
+		requeue := order.IsIceberg() && order.fillVisible(qty)
+		if order.Kind == KindMarket && !order.IsFilled() {
+			order.Cancel()
+		}
+		if qty > 0 {
+			run.cancelled = append(run.cancelled, o.cancelOCOGroup(&order)...)
+		}
+		if _, ok := o.brackets[order.ID]; ok {
+			if qty > 0 {
+				run.fills = append(run.fills, bracketFill{entryID: order.ID, qty: qty, done: order.IsFilled() || order.IsCancelled()})
+			} else if order.IsCancelled() {
+				delete(o.brackets, order.ID) // the entry was never filled
+			}
+		}
+		if err := o.updateActiveOrder(ctx, order); err != nil {
+			return err
+		}
+
+		switch {
+		case order.IsCancelled():
+			o.removeFromBooks(ctx, order.ID)
+			if !prevented[order.ID] { // the self-trade prevention published the cancel already
+				o.publishEvent(EventTypeCancelled, &order, fmt.Sprintf("market order not executed in the auction at %s", &result.Price))
+			}
+		case order.IsFilled():
+			o.removeFromBooks(ctx, order.ID)
+		case requeue:
+			o.requeueOrder(order.ID)
+		}
+	}
+	o.updateMarketPrice(ctx, result.Price)
+
+	o.removeCancelled(ctx, run.cancelled, "OCO")
+	o.activateBrackets(ctx, run.fills)
+	return nil
+}
+
+// publishIndicative publishes the indicative price and volume of the auction when they changed
+// while orders are collected, o.matchMutex has to be held.
+func (o *OrderBook) publishIndicative() {
+	if !o.collecting() {
+		return
+	}
+
+	bids, asks := o.auctionOrders()
+	result, ok, err := o.auctionPrice(bids, asks)
+	if err != nil {
+		return
+	}
+	previous := o.indicative
+	if !ok && previous == nil {
+		return
+	}
+	if ok && previous != nil && previous.Volume == result.Volume && previous.Imbalance == result.Imbalance &&
+		previous.Price.Cmp(&result.Price) == 0 {
+		return
+	}
+
+	event := Event{
+		ID:           uuid.New().String(),
+		Type:         EventTypeIndicativeAuction,
+		TickerSymbol: o.TickerSymbol,
+		Reason:       "the collected orders don't cross",
+		Timestamp:    time.Now(),
+	}
+	o.indicative = nil
+	if ok {
+		o.indicative = &result
+		event.Price = result.Price
+		event.Qty = result.Volume
+		event.Reason = fmt.Sprintf("imbalance %d", result.Imbalance)
+	}
+	o.Events <- event
+}
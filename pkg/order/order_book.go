@@ -2,8 +2,10 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math"
 	"sync"
 	"time"
 
@@ -20,16 +22,48 @@ type OrderBook struct {
 	TickerSymbol string
 
 	marketPrice      apd.Decimal
+	referencePrice   apd.Decimal // the price band is set around it, e.g. the previous close
 	marketPriceMutex sync.RWMutex
 
 	orderRepo    Repository       // persistent order storage
 	activeOrders map[string]Order // quick order retrieval by ID
 	orderMutex   sync.RWMutex
 
-	orders     *Set // contains all orders
-	stopOrders *Set
+	orders       *Set // contains all orders
+	stopOrders   *Set
+	peggedOrders map[string]bool           // IDs of the resting pegged orders, repriced when the top of the books changes
+	ocoGroups    map[ocoKey][]string       // IDs of the stored orders of one-cancels-other groups
+	brackets     map[string]*bracket       // bracket children waiting for fills of their entry order, by the entry ID
+	finished     *finishedOrders           // the latest finished orders, a cancel of them fails as finished
+	clientOrders map[clientOrderKey]string // IDs of the stored orders by their client order IDs
+
+	instrument Instrument        // tick size, lot size and the limits of the orders
+	algorithm  MatchingAlgorithm // allocates incoming orders among the resting orders of a price level
+
+	matchMutex sync.Mutex       // serializes order entry, cancels and expiries
+	expiry     *expiryScheduler // expires good-till-date and good-for-day orders
+	halt       *tradingHalt     // set while trading is halted, o.matchMutex guards it
+
+	phase       Phase          // the trading phase, o.matchMutex guards it
+	schedule    Schedule       // changes the trading phase at the scheduled times
+	nextPhaseAt time.Time      // the scheduled time of the next phase
+	phaseTimer  *time.Timer    // changes the phase at nextPhaseAt
+	indicative  *AuctionResult // the last published indicative auction, nil when the collected orders don't cross
+	sequence    uint64         // the last arrival sequence of the trackers, o.matchMutex guards it
 
 	TradeEvents chan EventTradeSuccess
+	Events      chan Event
+}
+
+// ExecutionReport describes how the order book handled a new order.
+type ExecutionReport struct {
+	Order     Order // the order after it was matched and stored
+	Matched   bool  // the order was matched (partially or fully)
+	Repriced  bool  // the post-only order was repriced to not take liquidity
+	Requeued  bool  // the amended order lost its time priority
+	Duplicate bool  // the order was submitted before with the same client order ID, this is the original report
+
+	MarketRemainder MarketRemainder // what happened to the rest of a market order
 }
 
 type EventTradeSuccess struct {
@@ -44,9 +78,15 @@ type EventTradeSuccess struct {
 
 	BidOrderID string
 	AskOrderID string
+
+	Aggressor Side        // the side of the incoming order which took liquidity, the other side is the maker, SideNone in an auction
+	BuyerFee  apd.Decimal // the fee of the buyer, negative for a maker rebate
+	SellerFee apd.Decimal // the fee of the seller, negative for a maker rebate
+
+	Auction bool // the trade was executed in a call auction, neither side is the maker
 }
 
-func NewOrderBook(symbol string, marketPrice apd.Decimal, orderRepo Repository) *OrderBook {
+func NewOrderBook(symbol string, marketPrice apd.Decimal, orderRepo Repository, opts ...BookOption) *OrderBook {
 	// bid need price high
 	bid := newComparator(true)
 	// asker need price lower
@@ -55,36 +95,55 @@ func NewOrderBook(symbol string, marketPrice apd.Decimal, orderRepo Repository)
 	stopBidLess := newStopComparator(false)
 	stopAskLess := newStopComparator(true)
 
-	return &OrderBook{
+	book := &OrderBook{
 		TickerSymbol: symbol,
 		marketPrice:  marketPrice,
 		orderRepo:    orderRepo,
 		activeOrders: make(map[string]Order),
 		orders:       NewOrderSet(bid, ask),
 		stopOrders:   NewOrderSet(stopBidLess, stopAskLess),
+		peggedOrders: make(map[string]bool),
+		ocoGroups:    make(map[ocoKey][]string),
+		brackets:     make(map[string]*bracket),
+		finished:     newFinishedOrders(finishedOrdersSize),
+		clientOrders: make(map[clientOrderKey]string),
+		instrument:   Instrument{TickSize: DefaultTickSize},
+		algorithm:    FIFO{},
 		TradeEvents:  make(chan EventTradeSuccess, 10000),
+		Events:       make(chan Event, 10000),
+	}
+	for _, opt := range opts {
+		opt.apply(book)
 	}
+	book.expiry = newExpiryScheduler(func(now time.Time) {
+		book.expireOrders(context.Background(), now)
+	})
+	book.startSchedule(time.Now())
+
+	return book
 }
 
-// GetBids Get all bids ordered the same way they are matched.
+// GetBids Get all bids ordered the same way they are matched. Iceberg orders show only their displayed tranche.
 func (o *OrderBook) GetBids() []Order {
 	o.orderMutex.RLock()
 	defer o.orderMutex.RUnlock()
 	orders := make([]Order, 0, o.orders.Len(SideBuy))
 	for iter := o.orders.Iterator(SideBuy); iter.Valid(); iter.Next() {
-		orders = append(orders, o.activeOrders[iter.Key().ID])
+		order := o.activeOrders[iter.Key().ID]
+		orders = append(orders, order.Displayed())
 	}
 
 	return orders
 }
 
-// GetAsks Get all asks ordered the same way they are matched.
+// GetAsks Get all asks ordered the same way they are matched. Iceberg orders show only their displayed tranche.
 func (o *OrderBook) GetAsks() []Order {
 	o.orderMutex.RLock()
 	defer o.orderMutex.RUnlock()
 	orders := make([]Order, 0, o.orders.Len(SideSell))
 	for iter := o.orders.Iterator(SideSell); iter.Valid(); iter.Next() {
-		orders = append(orders, o.activeOrders[iter.Key().ID])
+		order := o.activeOrders[iter.Key().ID]
+		orders = append(orders, order.Displayed())
 	}
 	return orders
 }
@@ -120,25 +179,65 @@ func (o *OrderBook) MarketPrice() apd.Decimal {
 }
 
 // SetMarketPrice Set a market price.
-func (o *OrderBook) SetMarketPrice(ctx context.Context, price apd.Decimal, fPrice float64) {
+// The crossed stop orders are triggered once continuous trading starts when the order book doesn't match orders.
+func (o *OrderBook) SetMarketPrice(ctx context.Context, price apd.Decimal) {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+
+	o.updateMarketPrice(ctx, price)
+	if o.matching() {
+		o.triggerStopOrders(ctx, price, price)
+	}
+}
+
+// updateMarketPrice sets the last trade price and lets trailing stop orders follow it.
+func (o *OrderBook) updateMarketPrice(ctx context.Context, price apd.Decimal) {
 	o.marketPriceMutex.Lock()
 	o.marketPrice = price
 	o.marketPriceMutex.Unlock()
 
-	bids := o.stopOrders.FindAllBidsBelow(fPrice)
+	o.trailStopOrders(ctx, price)
+}
+
+// triggerStopOrders submits the stop orders crossed by the traded prices.
+// Bids are triggered by the highest and asks by the lowest traded price.
+func (o *OrderBook) triggerStopOrders(ctx context.Context, low, high apd.Decimal) {
+	bids := o.stopOrders.FindAllBidsBelow(&high)
 	o.addOrders(ctx, bids)
-	asks := o.stopOrders.FindAllAsksAbove(fPrice)
+	asks := o.stopOrders.FindAllAsksAbove(&low)
 	o.addOrders(ctx, asks)
 }
 
+// addOrders moves triggered stop orders from the stop orders to the books.
+// A stop-market order crosses the spread, a stop-limit order is matched and rests at its limit price.
 func (o *OrderBook) addOrders(ctx context.Context, trackers []OrderTracker) {
-	for _, bid := range trackers {
-		order, ok := o.findActiveOrder(bid.ID)
-		o.stopOrders.Remove(bid.ID)
+	for _, stopTracker := range trackers {
+		if _, ok := o.stopOrders.Find(stopTracker.ID); !ok {
+			continue // already triggered while submitting a previous stop order
+		}
+		order, ok := o.findActiveOrder(stopTracker.ID)
 		if !ok {
-			panic(fmt.Errorf("order with ID %s not found", bid.ID))
+			panic(fmt.Errorf("order with ID %s not found", stopTracker.ID))
+		}
+		if order.IsCancelled() {
+			o.removeFromBooks(ctx, order.ID)
+			continue
 		}
-		if _, err := o.submit(ctx, order, bid); err != nil {
+
+		// a triggered stop order cancels the rest of its group before it is matched
+		o.removeCancelled(ctx, o.cancelOCOGroup(&order), "OCO")
+
+		// the order is submitted again as a triggered order, keyed by its price and the trigger time
+		o.orderMutex.Lock()
+		o.stopOrders.Remove(order.ID)
+		delete(o.activeOrders, order.ID)
+		o.orderMutex.Unlock()
+
+		tracker := newOrderTracker(order)
+		tracker.Timestamp = time.Now().UnixNano()
+		tracker.Sequence = o.nextSequence()
+
+		if _, err := o.submit(ctx, order, tracker); err != nil {
 			log.Println(err) // todo: better handling of these events
 		}
 	}
@@ -175,7 +274,19 @@ func (o *OrderBook) storeOrder(ctx context.Context, order Order) error {
 		o.orders.Remove(order.ID)
 		return err
 	}
-	return o.orderRepo.SaveOrder(ctx, &order)
+	if order.HasExpiry() {
+		o.expiry.schedule(order.ID, order.ExpireAt)
+	}
+	o.orderMutex.Lock()
+	if order.IsPegged() {
+		o.peggedOrders[order.ID] = true
+	}
+	o.joinOCOGroup(&order)
+	if order.ClientOrderID != "" {
+		o.clientOrders[newClientOrderKey(&order)] = order.ID
+	}
+	o.orderMutex.Unlock()
+	return o.saveOrder(ctx, &order)
 }
 
 // Update an active order.
@@ -186,7 +297,7 @@ func (o *OrderBook) updateActiveOrder(ctx context.Context, order Order) error {
 		return fmt.Errorf("order with ID %s hasn't yet been saved", order.ID)
 	}
 	o.activeOrders[order.ID] = order
-	return o.orderRepo.SaveOrder(ctx, &order)
+	return o.saveOrder(ctx, &order)
 }
 
 // Removes an order from books - removes it from possible matches.
@@ -195,72 +306,226 @@ func (o *OrderBook) removeFromBooks(ctx context.Context, orderID string) {
 	if !ok {
 		return
 	}
-	if err := o.orderRepo.SaveOrder(ctx, &order); err != nil { // ensure we store the latest order data
+	if err := o.saveOrder(ctx, &order); err != nil { // ensure we store the latest order data
 		log.Printf("cannot save the order %+v to the repo - repository data might be inconsistent\n", order.ID)
 	}
 
 	o.orderMutex.Lock()
 	o.orders.Remove(orderID)
+	o.stopOrders.Remove(orderID)
+	delete(o.peggedOrders, orderID)
+	o.leaveOCOGroup(&order)
+	if order.ClientOrderID != "" {
+		delete(o.clientOrders, newClientOrderKey(&order))
+	}
 	delete(o.activeOrders, orderID) // remove an active order
 	o.orderMutex.Unlock()
+	o.expiry.cancel(orderID)
+	o.publishIndicative()
 }
 
-// Cancel an order.
-func (o *OrderBook) Cancel(ctx context.Context, id string) error {
-	o.orderMutex.RLock()
-	order, ok := o.activeOrders[id]
-	o.orderMutex.RUnlock()
+// nextSequence returns the arrival sequence of a tracker entering the books, o.matchMutex has to be held.
+func (o *OrderBook) nextSequence() uint64 {
+	o.sequence++
+	return o.sequence
+}
 
+// requeueOrder moves the order behind the other orders at its price level, the order loses its time priority.
+func (o *OrderBook) requeueOrder(orderID string) {
+	o.orderMutex.Lock()
+	defer o.orderMutex.Unlock()
+
+	tracker, ok := o.orders.Find(orderID)
 	if !ok {
-		return nil
+		return
 	}
+	o.orders.Remove(orderID)
+	tracker.Timestamp = time.Now().UnixNano()
+	tracker.Sequence = o.nextSequence()
+	o.orders.Add(tracker)
+}
+
+// Cancel an order and remove it from the books. Returns the cancelled order with its final filled quantity.
+func (o *OrderBook) Cancel(ctx context.Context, id string) (Order, error) {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+
+	order, _, err := o.cancel(ctx, id)
+	if err != nil {
+		return order, err
+	}
+	o.repegOrders(ctx)
+	return order, nil
+}
+
+// cancel an order and its OCO group, o.matchMutex has to be held. Returns the cancelled order and its group.
+func (o *OrderBook) cancel(ctx context.Context, id string) (Order, []Order, error) {
+	order, ok := o.findActiveOrder(id)
+	if !ok {
+		order, err := o.findFinishedOrder(ctx, id)
+		return order, nil, err
+	}
+
 	order.Cancel()
-	return o.updateActiveOrder(ctx, order) // todo: remove from active orders
+	o.orderMutex.Lock()
+	o.activeOrders[id] = order
+	o.orderMutex.Unlock()
+	o.removeFromBooks(ctx, id) // stores the cancelled order
+
+	o.finishBracket(ctx, id)
+	group := o.cancelOCOGroup(&order)
+	o.removeCancelled(ctx, group, "OCO")
+	return order, group, nil
+}
+
+// FindClientOrder finds an order in the books by the client order ID of the customer.
+func (o *OrderBook) FindClientOrder(customerID, clientOrderID string) (Order, bool) {
+	if clientOrderID == "" {
+		return Order{}, false
+	}
+	o.orderMutex.RLock()
+	defer o.orderMutex.RUnlock()
+	id, ok := o.clientOrders[clientOrderKey{customerID: customerID, clientOrderID: clientOrderID}]
+	if !ok {
+		return Order{}, false
+	}
+	order, ok := o.activeOrders[id]
+	return order, ok
+}
+
+// findFinishedOrder looks up an order which is not in the books anymore.
+func (o *OrderBook) findFinishedOrder(ctx context.Context, id string) (Order, error) {
+	if order, ok := o.finished.find(id); ok {
+		return order, ErrOrderFinished
+	}
+	order, err := o.orderRepo.FindOrder(ctx, id)
+	if err != nil {
+		return Order{}, err
+	}
+	if order == nil {
+		return Order{}, ErrOrderNotFound
+	}
+	return *order, ErrOrderFinished
 }
 
 // Add a new order. Order can be matched immediately or later (or never), depending on order parameters and order type.
 // Returns true if order was matched (partially or fully), false otherwise.
 func (o *OrderBook) Add(ctx context.Context, order Order) (bool, error) {
-	if order.Qty <= MinQty { // check the qty
-		return false, ErrInvalidQty
+	report, err := o.Place(ctx, order)
+	return report.Matched, err
+}
+
+// Place a new order the same way as Add and report how the book handled it.
+func (o *OrderBook) Place(ctx context.Context, order Order) (ExecutionReport, error) {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+
+	if err := o.checkHalted(); err != nil {
+		return ExecutionReport{}, err
+	}
+	return o.placeChecked(ctx, order)
+}
+
+// placeChecked checks the new order can be placed in the current phase with a valid quantity and places it,
+// o.matchMutex has to be held.
+func (o *OrderBook) placeChecked(ctx context.Context, order Order) (ExecutionReport, error) {
+	if err := o.checkPhase(&order); err != nil {
+		return ExecutionReport{}, err
+	}
+	if !order.IsNotional() && order.Qty <= MinQty { // check the qty
+		return ExecutionReport{}, ErrInvalidQty
 	}
+	return o.place(ctx, order)
+}
+
+// prepare checks the order and sets what is derived from the books, e.g. the price of a pegged order.
+func (o *OrderBook) prepare(order *Order) error {
 	if order.Kind == KindMarket && !order.Price.IsZero() {
-		return false, ErrInvalidMarketPrice
+		return ErrInvalidMarketPrice
+	}
+	if !order.STPMode.IsValid() {
+		return ErrInvalidSTPMode
+	}
+	if order.IsNotional() && (order.Notional.Sign() < 0 || order.Qty != 0 || order.IsPegged() || order.DisplayQty != 0 ||
+		order.Params.Is(ConditionStop) || order.Params.Is(ConditionAON) || order.Params.Is(ConditionPostOnly)) {
+		return ErrInvalidNotional
+	}
+	if order.IsPegged() { // the price of a pegged order follows the books
+		if !order.HasValidPeg() {
+			return ErrInvalidPeg
+		}
+		price, ok, err := o.pegPrice(order)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrPegPriceNotFound
+		}
+		order.Price = price
 	}
 	if order.Kind == KindLimit && order.Price.IsZero() {
-		return false, ErrInvalidLimitPrice
+		return ErrInvalidLimitPrice
+	}
+	if order.Params.Is(ConditionPostOnly) && order.Kind != KindLimit {
+		return ErrInvalidPostOnly
+	}
+	if order.DisplayQty < 0 || order.DisplayQty > order.Qty || (order.DisplayQty > 0 && order.Kind != KindLimit) {
+		return ErrInvalidDisplayQty
+	}
+	if order.IsIceberg() {
+		order.Replenish()
+	}
+	if order.Params.Is(ConditionTrailingStop) {
+		if !order.HasValidTrail() {
+			return ErrInvalidTrail
+		}
+		if order.StopPrice.IsZero() { // start trailing from the current market price
+			marketPrice := o.MarketPrice()
+			stopPrice, err := order.trailStopPrice(&marketPrice)
+			if err != nil {
+				return err
+			}
+			order.StopPrice = stopPrice
+		}
 	}
 	if order.Params.Is(ConditionStop) && order.StopPrice.IsZero() {
-		return false, ErrInvalidStopPrice
+		return ErrInvalidStopPrice
 	}
-
-	orderPrice, err := order.Price.Float64()
-	if err != nil {
-		return false, err
+	if order.Params.Is(ConditionGTD) && !order.ExpireAt.After(time.Now()) {
+		return ErrInvalidExpireAt
+	}
+	if order.Params.Is(ConditionGFD) && order.ExpireAt.IsZero() {
+		order.ExpireAt = endOfDay(order.CreatedAt)
+	}
+	if err := o.instrument.validate(order); err != nil {
+		return err
+	}
+	// a stop order is checked by the band when it is triggered and matched
+	if order.Kind == KindLimit && !order.IsPegged() && !order.Params.Is(ConditionStop) {
+		return o.checkPriceBand(&order.Price)
 	}
+	return nil
+}
 
-	tracker := OrderTracker{
-		ID:        order.ID,
-		Kind:      order.Kind,
-		Price:     orderPrice,
-		Side:      order.Side,
-		Timestamp: order.CreatedAt.UnixNano(),
+// place an order with a checked quantity in the books, o.matchMutex has to be held.
+func (o *OrderBook) place(ctx context.Context, order Order) (ExecutionReport, error) {
+	if err := o.prepare(&order); err != nil {
+		return ExecutionReport{}, err
+	}
+	if _, ok := o.FindClientOrder(order.CustomerID, order.ClientOrderID); ok {
+		return ExecutionReport{}, ErrDuplicateClientID
 	}
 
+	tracker := newOrderTracker(order)
+	tracker.Sequence = o.nextSequence()
 	if order.Params.Is(ConditionStop) {
 		marketPrice := o.MarketPrice()
+		stopTracker := newStopTracker(order)
+		stopTracker.Sequence = tracker.Sequence
 
-		orderStopPrice, err := order.StopPrice.Float64()
-		if err != nil {
-			return false, err
-		}
-
-		tracker := OrderTracker{
-			ID:        order.ID,
-			Kind:      order.Kind,
-			Price:     orderStopPrice,
-			Side:      order.Side,
-			Timestamp: order.CreatedAt.UnixNano(),
+		// stop orders are triggered once continuous trading starts when orders are not matched
+		if !o.matching() {
+			return ExecutionReport{Order: order}, o.addToStopOrders(ctx, order, stopTracker)
 		}
 
 		switch order.Side {
@@ -268,60 +533,184 @@ func (o *OrderBook) Add(ctx context.Context, order Order) (bool, error) {
 			// if market price is lower than the bid stop price add as a stop order
 			// otherwise process immediately
 			if marketPrice.Cmp(&order.StopPrice) < 0 {
-				o.stopOrders.Add(tracker)
-				if err := o.storeOrder(ctx, order); err != nil {
-					return false, err
-				}
-				return false, nil
+				return ExecutionReport{Order: order}, o.addToStopOrders(ctx, order, stopTracker)
 			}
 		case SideSell:
 			// if market price is higher than the ask stop price add as a stop order
 			// otherwise proces immediately
 			if marketPrice.Cmp(&order.StopPrice) > 0 {
-				o.stopOrders.Add(tracker)
-				if err := o.storeOrder(ctx, order); err != nil {
-					return false, err
-				}
-				return false, nil
+				return ExecutionReport{Order: order}, o.addToStopOrders(ctx, order, stopTracker)
 			}
 		}
 	}
 
-	return o.submit(ctx, order, tracker)
+	if !o.matching() {
+		return o.rest(ctx, order, tracker)
+	}
+	report, err := o.submit(ctx, order, tracker)
+	if err != nil {
+		return report, err
+	}
+	o.repegOrders(ctx)
+	return report, nil
 }
 
-// submit an order for matching and store it. Returns true if matched (partially or fully), false if not.
-func (o *OrderBook) submit(ctx context.Context, order Order, tracker OrderTracker) (bool, error) {
-	var matched bool
+// addToStopOrders stores a stop order until the market price crosses its stop price.
+func (o *OrderBook) addToStopOrders(ctx context.Context, order Order, stopTracker OrderTracker) error {
+	o.orderMutex.Lock()
+	o.stopOrders.Add(stopTracker)
+	o.orderMutex.Unlock()
 
+	if err := o.storeOrder(ctx, order); err != nil {
+		o.stopOrders.Remove(order.ID)
+		return err
+	}
+	return nil
+}
+
+// submit an order for matching and store it. Reports whether it was matched (partially or fully).
+func (o *OrderBook) submit(ctx context.Context, order Order, tracker OrderTracker) (ExecutionReport, error) {
+	var (
+		report ExecutionReport
+		run    matchRun
+	)
+	filledQty := order.FilledQty
+	market := order.Kind == KindMarket
+	if order.HasExpiry() { // an order which doesn't rest once it is submitted is never expired
+		defer func() {
+			if _, ok := o.findActiveOrder(order.ID); !ok {
+				o.expiry.cancel(order.ID)
+			}
+		}()
+	}
+	if order.IsNotional() { // the quantity follows from the notional while the order is matched
+		order.Qty = math.MaxInt64
+	}
+
+	collar, err := o.marketCollar(&order)
+	if err != nil {
+		return ExecutionReport{Order: order}, err
+	}
+	run.collar = collar
+
+	offers := o.orders.Bids // order is an ask, match with bids
 	if order.IsBid() {
-		// order is a bid, match with asks
-		matched, _ = o.matchOrder(ctx, tracker.Price, &order, o.orders.Asks)
-	} else {
-		// order is an ask, match with bids
-		matched, _ = o.matchOrder(ctx, tracker.Price, &order, o.orders.Bids)
+		offers = o.orders.Asks // order is a bid, match with asks
+	}
+	for {
+		// match again while replenished iceberg orders might fill the rest of the order
+		run.replenished = false
+		matched, err := o.matchOrder(ctx, &order, offers, &run)
+		report.Matched = report.Matched || matched
+		if errors.Is(err, ErrPostOnlyWouldTake) {
+			if err := o.slidePostOnly(&order, &tracker, &run.crossed); err != nil {
+				return ExecutionReport{Order: order}, err
+			}
+			report.Repriced = true
+			break
+		}
+		if !run.replenished || order.IsFilled() || order.IsCancelled() {
+			break
+		}
+	}
+	if order.IsNotional() {
+		order.finishNotional()
+	}
+	if run.bandBreached {
+		if err := o.stopAtPriceBand(&order, &tracker, &run); err != nil {
+			return ExecutionReport{Order: order}, err
+		}
+	}
+	o.limitMarketRemainder(&order, &tracker, &run)
+
+	// bracket children are placed once the order is matched and stored
+	defer func() {
+		o.activateBrackets(ctx, run.fills)
+	}()
+
+	// a fill of any order of a group cancels the others
+	if report.Matched {
+		run.cancelled = append(run.cancelled, o.cancelOCOGroup(&order)...)
+	}
+	o.removeCancelled(ctx, run.cancelled, "OCO")
+
+	// stop orders are triggered once the order is matched, so they never interleave with its matching
+	if run.traded {
+		defer o.triggerStopOrders(ctx, run.low, run.high)
+	}
+	if order.IsIceberg() {
+		order.Replenish() // show a full tranche of what is left
 	}
 
 	addToBooks := true
 
 	if order.Params.Is(ConditionIOC) && !order.IsFilled() {
-		order.Cancel()                                             // cancel the rest of the order
-		if err := o.orderRepo.SaveOrder(ctx, &order); err != nil { // store the order (not in the books)
-			return matched, err
+		order.Cancel() // cancel the rest of the order
+	}
+	if order.IsCancelled() { // cancelled as IOC, by self-trade prevention or at the price band
+		if err := o.saveOrder(ctx, &order); err != nil { // store the order (not in the books)
+			return report, err
 		}
 		addToBooks = false // don't add the order to the books (keep it stored but not active)
 	}
 
-	if !order.IsFilled() && addToBooks {
+	if _, ok := o.brackets[order.ID]; ok {
+		if order.FilledQty > filledQty {
+			run.fills = append(run.fills, bracketFill{
+				entryID: order.ID,
+				qty:     order.FilledQty - filledQty,
+				done:    order.IsFilled() || order.IsCancelled(),
+			})
+		} else if order.IsCancelled() {
+			delete(o.brackets, order.ID) // the entry was never filled
+		}
+	}
+
+	report.Order = order
+	if market && !order.IsFilled() {
+		switch {
+		case order.IsCancelled():
+			report.MarketRemainder = MarketRemainderCancelled
+		case order.Kind == KindLimit:
+			report.MarketRemainder = MarketRemainderLimited
+		}
+	}
+	if order.IsFilled() {
+		return report, o.saveOrder(ctx, &order) // store the filled order (not in the books)
+	}
+	if addToBooks {
 		o.addToBooks(tracker)
 		if err := o.storeOrder(ctx, order); err != nil {
-			return matched, err
+			return report, err
 		}
 	}
-	return matched, nil
+	return report, nil
+}
+
+// matchRun collects what happened while an order was matched.
+type matchRun struct {
+	low, high         apd.Decimal // the lowest and the highest traded price
+	traded            bool
+	replenished       bool          // an iceberg order was replenished and lost its time priority
+	crossed           apd.Decimal   // the opposite best price a post-only order would cross
+	bandBreached      bool          // matching stopped at a price outside the price band
+	bandLow, bandHigh apd.Decimal   // the price band while the order was matched
+	collar            apd.Decimal   // the price a market order matches up to by the collar policy, zero without collar
+	cancelled         []Order       // OCO siblings of the filled orders, removed once the order is matched
+	fills             []bracketFill // fills of bracket entries, their children are placed once the order is matched
 }
 
-func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *Order, offers *treemap.TreeMap[OrderTracker, bool]) (matched bool, err error) {
+func (r *matchRun) trade(price apd.Decimal) {
+	if !r.traded || price.Cmp(&r.low) < 0 {
+		r.low = price
+	}
+	if !r.traded || price.Cmp(&r.high) > 0 {
+		r.high = price
+	}
+	r.traded = true
+}
+
+func (o *OrderBook) matchOrder(ctx context.Context, order *Order, offers *treemap.TreeMap[OrderTracker, bool], run *matchRun) (matched bool, err error) {
 	var (
 		buyer, seller          string
 		bidOrderID, askOrderID string
@@ -337,13 +726,37 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 		askOrderID = order.ID
 	}
 
+	bandLow, bandHigh, banded, err := o.priceBand()
+	if err != nil {
+		return false, err
+	}
+
+	// all-or-nothing orders are filled by a single resting order in price-time priority
+	allocating := o.allocatesLevels() && !order.Params.Is(ConditionAON)
+	var (
+		allocations map[string]int64 // the allocation of the order among the resting orders of the price level
+		levelPrice  apd.Decimal
+	)
+
 	removeOrders := make([]string, 0)
+	requeueOrders := make([]string, 0)
 	defer func() {
 		for _, orderID := range removeOrders {
 			o.removeFromBooks(ctx, orderID)
 		}
+		for _, orderID := range requeueOrders {
+			o.requeueOrder(orderID)
+		}
 	}()
 
+	if order.Params.Is(ConditionPostOnly) { // a post-only order never takes liquidity
+		if crossed, ok := o.crossedPrice(order, offers); ok {
+			run.crossed = crossed
+			return false, ErrPostOnlyWouldTake
+		}
+		return false, nil
+	}
+
 	for iter := offers.Iterator(); iter.Valid(); iter.Next() {
 		oppositePartialOrder := iter.Key()
 		oppositeOrder, ok := o.findActiveOrder(oppositePartialOrder.ID)
@@ -356,7 +769,13 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 			continue                                              // don't match with this order
 		}
 
-		qty := min(order.UnfilledQty(), oppositeOrder.UnfilledQty())
+		available := oppositeOrder.UnfilledQty()
+		if oppositeOrder.IsIceberg() && !order.Params.Is(ConditionAON) && !oppositeOrder.Params.Is(ConditionAON) {
+			// only the displayed tranche is matched, the hidden quantity counts only to fill AONs
+			available = oppositeOrder.VisibleQty
+		}
+
+		qty := min(order.UnfilledQty(), available)
 		// ensure AONs are complete filled
 
 		// require AON but couldn't fill the order in one trade
@@ -370,7 +789,6 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 		}
 
 		var price apd.Decimal
-		var fPrice float64
 
 		// look only after the best available price
 		switch order.Kind {
@@ -382,7 +800,6 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 			case KindLimit:
 				// crossing the spread
 				price = oppositeOrder.Price
-				fPrice = oppositePartialOrder.Price
 			default:
 				// handle error
 				return false, fmt.Errorf("not support order kind %w", ErrInternal)
@@ -393,7 +810,6 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 				switch oppositeOrder.Kind {
 				case KindMarket:
 					price = myPrice
-					fPrice = orderPrice
 				case KindLimit:
 					// check if we can cross the spread
 					if myPrice.Cmp(&oppositeOrder.Price) < 0 {
@@ -404,7 +820,6 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 						// our bid is higher or equal to their ask - set price to myPrice
 						// e.g.: our bid is $20.10, their ask is $20 - trade executes at $20.10
 						price = myPrice
-						fPrice = orderPrice
 					}
 				default:
 					return false, fmt.Errorf("not support order kind 1 %w", ErrInternal)
@@ -415,7 +830,6 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 				// we have a limit, they are buying at our specified price
 				case KindMarket:
 					price = myPrice
-					fPrice = orderPrice
 				case KindLimit:
 					// check if we can cross the spread
 					if myPrice.Cmp(&oppositeOrder.Price) > 0 {
@@ -424,7 +838,6 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 					} else {
 						// our ask is lower or equal to their bid - match!
 						price = oppositeOrder.Price // set price to their bid
-						fPrice = oppositePartialOrder.Price
 					}
 				default:
 					return false, fmt.Errorf("not support order kind 2 %w", ErrInternal)
@@ -435,6 +848,55 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 			return false, fmt.Errorf("not support order kind 3 %w", ErrInternal)
 		}
 
+		// never trade outside the price band, the offers behind are even further away
+		if banded && (price.Cmp(&bandLow) < 0 || price.Cmp(&bandHigh) > 0) {
+			run.bandBreached = true
+			run.bandLow, run.bandHigh = bandLow, bandHigh
+			return matched, nil
+		}
+		// a collared market order never trades beyond its collar, the offers behind are even further away
+		if !run.collar.IsZero() && ((buying && price.Cmp(&run.collar) > 0) || (!buying && price.Cmp(&run.collar) < 0)) {
+			return matched, nil
+		}
+		if order.IsNotional() {
+			affordable, err := o.notionalQty(order, &price)
+			if err != nil {
+				return matched, err
+			}
+			if qty = min(qty, affordable); qty == 0 { // less than a lot is left at the best price
+				return matched, nil
+			}
+		}
+
+		if allocating && oppositeOrder.Kind == KindLimit {
+			if allocations == nil || oppositePartialOrder.Price.Cmp(&levelPrice) != 0 {
+				var removed []string
+				levelPrice = oppositePartialOrder.Price
+				allocations, removed, err = o.allocateLevel(ctx, order, iter, run)
+				removeOrders = append(removeOrders, removed...)
+				if err != nil {
+					return matched, err
+				}
+				if order.IsCancelled() {
+					return matched, nil
+				}
+			}
+			// self-trades were prevented by the allocation
+			if qty = min(qty, allocations[oppositeOrder.ID]); qty == 0 {
+				continue
+			}
+		}
+
+		if order.STPMode != STPNone && isSelfTrade(order, &oppositeOrder) {
+			if o.preventSelfTrade(ctx, order, &oppositeOrder, run) {
+				removeOrders = append(removeOrders, oppositeOrder.ID)
+			}
+			if order.IsCancelled() {
+				return matched, nil
+			}
+			continue
+		}
+
 		if buying {
 			seller = oppositeOrder.CustomerID
 			askOrderID = oppositeOrder.ID
@@ -443,20 +905,38 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 			bidOrderID = oppositeOrder.ID
 		}
 
+		total, err := fillNotional(&price, qty, order, &oppositeOrder)
+		if err != nil {
+			return matched, err
+		}
 		order.FilledQty += qty
 		oppositeOrder.FilledQty += qty
+		run.cancelled = append(run.cancelled, o.cancelOCOGroup(&oppositeOrder)...)
+		if _, ok := o.brackets[oppositeOrder.ID]; ok {
+			run.fills = append(run.fills, bracketFill{
+				entryID: oppositeOrder.ID,
+				qty:     qty,
+				done:    oppositeOrder.UnfilledQty() == 0,
+			})
+		}
+		if oppositeOrder.IsIceberg() && oppositeOrder.fillVisible(qty) {
+			requeueOrders = append(requeueOrders, oppositeOrder.ID)
+			run.replenished = true
+		}
 
 		matched = true
+		if err := o.updateActiveOrder(ctx, oppositeOrder); err != nil {
+			return matched, err
+		}
 		// if the other order is filled completely - remove it from the order book
 		if oppositeOrder.UnfilledQty() == 0 {
 			removeOrders = append(removeOrders, oppositeOrder.ID)
-		} else {
-			// otherwise update it
-			if err := o.updateActiveOrder(ctx, oppositeOrder); err != nil { // otherwise update it
-				return matched, err
-			}
 		}
 
+		buyerFee, sellerFee, err := o.instrument.Fees.fees(buyer, seller, order.Side, &total)
+		if err != nil {
+			return matched, err
+		}
 		event := EventTradeSuccess{
 			ID:           uuid.New().String(),
 			Buyer:        buyer,
@@ -464,15 +944,19 @@ func (o *OrderBook) matchOrder(ctx context.Context, orderPrice float64, order *O
 			TickerSymbol: o.TickerSymbol,
 			Qty:          qty,
 			Price:        price,
-			Total:        apd.Decimal{},
+			Total:        total,
 			Timestamp:    time.Now(),
 			BidOrderID:   bidOrderID,
 			AskOrderID:   askOrderID,
+			Aggressor:    order.Side,
+			BuyerFee:     buyerFee,
+			SellerFee:    sellerFee,
 		}
 
 		// fmt.Printf("%#v\n", event)
 		o.TradeEvents <- event
-		o.SetMarketPrice(ctx, price, fPrice)
+		o.updateMarketPrice(ctx, price)
+		run.trade(price)
 		// update tradeBook
 		if order.IsFilled() {
 			return true, nil
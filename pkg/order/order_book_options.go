@@ -0,0 +1,73 @@
+package order
+
+import "github.com/cockroachdb/apd"
+
+// DefaultTickSize is the minimum price increment of an order book without a configured tick size
+var DefaultTickSize = *apd.New(1, -2)
+
+// A BookOption is passed to NewOrderBook
+type BookOption interface {
+	apply(*OrderBook)
+}
+
+// setTickSize for implement book option pattern
+type setTickSize struct{ tickSize apd.Decimal }
+
+// apply implement BookOption interface
+func (opt *setTickSize) apply(o *OrderBook) { o.instrument.TickSize = opt.tickSize }
+
+// WithTickSize with the minimum price increment of the order book
+func WithTickSize(tickSize apd.Decimal) BookOption {
+	return &setTickSize{
+		tickSize: tickSize,
+	}
+}
+
+// setInstrument for implement book option pattern
+type setInstrument struct{ instrument Instrument }
+
+// apply implement BookOption interface, the default tick size is kept when the instrument doesn't set one
+func (opt *setInstrument) apply(o *OrderBook) {
+	tickSize := o.instrument.TickSize
+	o.instrument = opt.instrument
+	if o.instrument.TickSize.Sign() <= 0 {
+		o.instrument.TickSize = tickSize
+	}
+}
+
+// WithInstrument with the reference data orders of the order book are validated against
+func WithInstrument(instrument Instrument) BookOption {
+	return &setInstrument{
+		instrument: instrument,
+	}
+}
+
+// setSchedule for implement book option pattern
+type setSchedule struct{ schedule Schedule }
+
+// apply implement BookOption interface
+func (opt *setSchedule) apply(o *OrderBook) { o.schedule = opt.schedule }
+
+// WithSchedule with the times of the trading day the phases of the order book start at
+func WithSchedule(schedule Schedule) BookOption {
+	return &setSchedule{
+		schedule: schedule,
+	}
+}
+
+// setMatchingAlgorithm for implement book option pattern
+type setMatchingAlgorithm struct{ algorithm MatchingAlgorithm }
+
+// apply implement BookOption interface, FIFO is kept without algorithm
+func (opt *setMatchingAlgorithm) apply(o *OrderBook) {
+	if opt.algorithm != nil {
+		o.algorithm = opt.algorithm
+	}
+}
+
+// WithMatchingAlgorithm with the algorithm incoming orders are allocated among the resting orders of a price level by
+func WithMatchingAlgorithm(algorithm MatchingAlgorithm) BookOption {
+	return &setMatchingAlgorithm{
+		algorithm: algorithm,
+	}
+}
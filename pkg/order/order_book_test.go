@@ -2,6 +2,8 @@ package order
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -474,3 +476,2166 @@ func (suite *orderBookTestSuite) TestOrderBook_Add_MarketPrice_Change() {
 	_, err := BaseContext.Cmp(&eq, &ob.marketPrice, apd.New(2012, -2))
 	suite.NoError(err)
 }
+
+func (suite *orderBookTestSuite) TestOrderBook_GTD_Reject_Past_ExpireAt() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	order := createOrder("1", KindLimit, ConditionGTD, 5, *apd.New(2012, -2), apd.Decimal{}, SideBuy)
+	order.ExpireAt = time.Now().Add(-time.Minute)
+
+	matched, err := ob.Add(ctx, order)
+	suite.ErrorIs(err, ErrInvalidExpireAt)
+	suite.False(matched)
+	suite.Equal(0, ob.orders.Bids.Len())
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_GTD_Expire() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	order := createOrder("1", KindLimit, ConditionGTD, 5, *apd.New(2012, -2), apd.Decimal{}, SideBuy)
+	order.ExpireAt = time.Now().Add(50 * time.Millisecond)
+
+	matched, err := ob.Add(ctx, order)
+	suite.NoError(err)
+	suite.False(matched)
+	suite.Equal(1, len(ob.GetBids()))
+
+	suite.Eventually(func() bool {
+		return len(ob.GetBids()) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	event := <-ob.Events
+	suite.Equal(EventTypeExpired, event.Type)
+	suite.Equal("1", event.OrderID)
+	_, ok := ob.findActiveOrder("1")
+	suite.False(ok)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_GFD_Stop_Expire() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	tests := []struct {
+		matched bool
+		order   Order
+	}{
+		{
+			matched: false,
+			order:   createOrder("1", KindLimit, ConditionGFD|ConditionStop, 5, *apd.New(2200, -2), *apd.New(2100, -2), SideBuy),
+		},
+		{
+			matched: false,
+			order:   createOrder("2", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideBuy),
+		},
+	}
+	for _, tt := range tests {
+		matched, err := ob.Add(ctx, tt.order)
+		suite.NoError(err)
+		suite.Equal(tt.matched, matched)
+	}
+
+	stop, ok := ob.findActiveOrder("1")
+	suite.True(ok)
+	suite.Equal(endOfDay(stop.CreatedAt), stop.ExpireAt)
+
+	// nothing is due before the end of the day
+	ob.expireOrders(ctx, stop.ExpireAt.Add(-time.Second))
+	suite.Equal(1, ob.stopOrders.Bids.Len())
+
+	ob.expireOrders(ctx, stop.ExpireAt)
+	suite.Equal(0, ob.stopOrders.Bids.Len())
+	suite.Equal(1, ob.orders.Bids.Len())
+
+	event := <-ob.Events
+	suite.Equal(EventTypeExpired, event.Type)
+	suite.Equal("1", event.OrderID)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_GFD_Expiry_Removed() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	for _, order := range []Order{
+		createOrder("1", KindLimit, ConditionGFD, 5, *apd.New(2012, -2), apd.Decimal{}, SideBuy),
+		createOrder("2", KindLimit, ConditionGFD, 5, *apd.New(2010, -2), apd.Decimal{}, SideBuy),
+	} {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+	suite.Equal(2, ob.expiry.scheduled())
+
+	// a replaced order keeps its single entry
+	_, err := ob.Replace(ctx, "2", 5, *apd.New(2011, -2))
+	suite.NoError(err)
+	suite.Equal(2, ob.expiry.scheduled())
+
+	// cancelled and filled orders leave the schedule
+	_, err = ob.Cancel(ctx, "1")
+	suite.NoError(err)
+	suite.Equal(1, ob.expiry.scheduled())
+	_, err = ob.Add(ctx, createOrder("3", KindMarket, 0, 5, apd.Decimal{}, apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	suite.Equal(0, ob.expiry.scheduled())
+
+	// an incoming order filled at once is never scheduled
+	_, err = ob.Add(ctx, createOrder("4", KindLimit, 0, 5, *apd.New(2020, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("5", KindLimit, ConditionGFD, 5, *apd.New(2020, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.Equal(0, ob.expiry.scheduled())
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_TrailingStop_Reject_Without_Trail() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	matched, err := ob.Add(ctx, createOrder("1", KindMarket, ConditionTrailingStop, 5, apd.Decimal{}, apd.Decimal{}, SideSell))
+	suite.ErrorIs(err, ErrInvalidTrail)
+	suite.False(matched)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_TrailingStop_Ask() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	trailing := createOrder("1", KindMarket, ConditionTrailingStop, 5, apd.Decimal{}, apd.Decimal{}, SideSell)
+	trailing.TrailAmount = *apd.New(100, -2)
+	matched, err := ob.Add(ctx, trailing)
+	suite.NoError(err)
+	suite.False(matched)
+
+	stopPrice := func() string {
+		tracker, ok := ob.stopOrders.Find("1")
+		suite.True(ok)
+		return tracker.Price.String()
+	}
+	suite.Equal("19.25", stopPrice()) // market price 20.25 minus the trail
+
+	tests := []struct {
+		matched   bool
+		order     Order
+		stopPrice string
+	}{
+		// the market rises, the stop price follows
+		{
+			matched: false,
+			order:   createOrder("2", KindLimit, 0, 2, *apd.New(2100, -2), apd.Decimal{}, SideSell),
+		},
+		{
+			matched:   true,
+			order:     createOrder("3", KindLimit, 0, 2, *apd.New(2100, -2), apd.Decimal{}, SideBuy),
+			stopPrice: "20.00",
+		},
+		// the market falls but not by the trail, the stop price stays
+		{
+			matched: false,
+			order:   createOrder("4", KindLimit, 0, 2, *apd.New(2050, -2), apd.Decimal{}, SideSell),
+		},
+		{
+			matched:   true,
+			order:     createOrder("5", KindLimit, 0, 2, *apd.New(2050, -2), apd.Decimal{}, SideBuy),
+			stopPrice: "20.00",
+		},
+	}
+	for _, tt := range tests {
+		matched, err := ob.Add(ctx, tt.order)
+		suite.NoError(err)
+		suite.Equal(tt.matched, matched)
+		if tt.stopPrice != "" {
+			suite.Equal(tt.stopPrice, stopPrice())
+		}
+	}
+
+	// the market reverses by the trail and fires the stop order
+	_, err = ob.Add(ctx, createOrder("6", KindLimit, 0, 8, *apd.New(1990, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	matched, err = ob.Add(ctx, createOrder("7", KindLimit, 0, 2, *apd.New(1990, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	suite.True(matched)
+
+	suite.Equal(0, ob.stopOrders.Asks.Len())
+	bid, ok := ob.findActiveOrder("6")
+	suite.True(ok)
+	suite.Equal(int64(7), bid.FilledQty)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_StopLimit_Rest_At_Limit_Price() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	tests := []struct {
+		matched bool
+		order   Order
+	}{
+		{
+			matched: false,
+			order:   createOrder("1", KindLimit, ConditionStop, 5, *apd.New(2060, -2), *apd.New(2050, -2), SideBuy),
+		},
+		{
+			matched: false,
+			order:   createOrder("2", KindLimit, 0, 2, *apd.New(2050, -2), apd.Decimal{}, SideSell),
+		},
+		{
+			matched: true,
+			order:   createOrder("3", KindLimit, 0, 2, *apd.New(2050, -2), apd.Decimal{}, SideBuy),
+		},
+	}
+	for _, tt := range tests {
+		matched, err := ob.Add(ctx, tt.order)
+		suite.NoError(err)
+		suite.Equal(tt.matched, matched)
+	}
+
+	suite.Equal(0, ob.stopOrders.Bids.Len())
+	suite.Equal(1, ob.orders.Bids.Len())
+
+	tracker, ok := ob.orders.Find("1")
+	suite.True(ok)
+	suite.Equal(KindLimit, tracker.Kind)
+	suite.Equal(0, tracker.Price.Cmp(apd.New(206, -1)))
+
+	bids := ob.GetBids()
+	suite.Equal("1", bids[0].ID)
+	suite.Equal(int64(0), bids[0].FilledQty)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_StopMarket_Cross_The_Spread() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	tests := []struct {
+		matched bool
+		order   Order
+	}{
+		{
+			matched: false,
+			order:   createOrder("1", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+		},
+		{
+			matched: false,
+			order:   createOrder("2", KindMarket, ConditionStop, 3, apd.Decimal{}, *apd.New(2010, -2), SideSell),
+		},
+		{
+			matched: false,
+			order:   createOrder("3", KindLimit, 0, 2, *apd.New(2005, -2), apd.Decimal{}, SideSell),
+		},
+		{
+			matched: true,
+			order:   createOrder("4", KindLimit, 0, 2, *apd.New(2005, -2), apd.Decimal{}, SideBuy),
+		},
+	}
+	for _, tt := range tests {
+		matched, err := ob.Add(ctx, tt.order)
+		suite.NoError(err)
+		suite.Equal(tt.matched, matched)
+	}
+
+	suite.Equal(0, ob.stopOrders.Asks.Len())
+	suite.Equal(0, ob.orders.Asks.Len())
+
+	trade := <-ob.TradeEvents
+	suite.Equal("4", trade.BidOrderID)
+	trade = <-ob.TradeEvents
+	suite.Equal("1", trade.BidOrderID)
+	suite.Equal("2", trade.AskOrderID)
+	suite.Equal(int64(3), trade.Qty)
+	suite.Equal(0, trade.Price.Cmp(apd.New(2000, -2)))
+
+	bid, ok := ob.findActiveOrder("1")
+	suite.True(ok)
+	suite.Equal(int64(3), bid.FilledQty)
+	_, ok = ob.findActiveOrder("2")
+	suite.False(ok)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_StopLimit_Triggered_By_Sweep() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	tests := []struct {
+		matched bool
+		order   Order
+	}{
+		{
+			matched: false,
+			order:   createOrder("1", KindLimit, ConditionStop, 3, *apd.New(1945, -2), *apd.New(1950, -2), SideSell),
+		},
+		{
+			matched: false,
+			order:   createOrder("2", KindLimit, 0, 2, *apd.New(1940, -2), apd.Decimal{}, SideSell),
+		},
+		{
+			matched: false,
+			order:   createOrder("3", KindLimit, 0, 2, *apd.New(1960, -2), apd.Decimal{}, SideSell),
+		},
+		// the sweep trades at 19.40 and 19.60, the lowest price triggers the stop ask
+		{
+			matched: true,
+			order:   createOrder("4", KindMarket, 0, 4, apd.Decimal{}, apd.Decimal{}, SideBuy),
+		},
+	}
+	for _, tt := range tests {
+		matched, err := ob.Add(ctx, tt.order)
+		suite.NoError(err)
+		suite.Equal(tt.matched, matched)
+	}
+
+	suite.Equal(0, ob.stopOrders.Asks.Len())
+	asks := ob.GetAsks()
+	suite.Len(asks, 1)
+	suite.Equal("1", asks[0].ID)
+
+	tracker, ok := ob.orders.Find("1")
+	suite.True(ok)
+	suite.Equal(0, tracker.Price.Cmp(apd.New(1945, -2)))
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Iceberg_Replenish_Loses_Priority() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	iceberg := createOrder("1", KindLimit, 0, 10, *apd.New(2010, -2), apd.Decimal{}, SideSell)
+	iceberg.DisplayQty = 3
+
+	tests := []struct {
+		matched bool
+		order   Order
+	}{
+		{
+			matched: false,
+			order:   iceberg,
+		},
+		{
+			matched: false,
+			order:   createOrder("2", KindLimit, 0, 2, *apd.New(2010, -2), apd.Decimal{}, SideSell),
+		},
+	}
+	for _, tt := range tests {
+		matched, err := ob.Add(ctx, tt.order)
+		suite.NoError(err)
+		suite.Equal(tt.matched, matched)
+	}
+
+	// only the displayed tranche is shown
+	asks := ob.GetAsks()
+	suite.Equal("1", asks[0].ID)
+	suite.Equal(int64(3), asks[0].Qty)
+
+	matched, err := ob.Add(ctx, createOrder("3", KindLimit, 0, 7, *apd.New(2010, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.True(matched)
+
+	// the first tranche is matched, then the order behind it, then the replenished tranche
+	expected := []struct {
+		askOrderID string
+		qty        int64
+	}{
+		{"1", 3},
+		{"2", 2},
+		{"1", 2},
+	}
+	for _, e := range expected {
+		trade := <-ob.TradeEvents
+		suite.Equal(e.askOrderID, trade.AskOrderID)
+		suite.Equal(e.qty, trade.Qty)
+	}
+
+	asks = ob.GetAsks()
+	suite.Len(asks, 1)
+	suite.Equal(int64(5), asks[0].FilledQty)
+	suite.Equal(int64(6), asks[0].Qty) // filled plus the visible tranche of 1
+
+	iceberg, ok := ob.findActiveOrder("1")
+	suite.True(ok)
+	suite.Equal(int64(1), iceberg.VisibleQty)
+	suite.Equal(int64(5), iceberg.UnfilledQty())
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Iceberg_Hidden_Fills_AON() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	iceberg := createOrder("1", KindLimit, 0, 10, *apd.New(2010, -2), apd.Decimal{}, SideSell)
+	iceberg.DisplayQty = 3
+
+	tests := []struct {
+		matched bool
+		order   Order
+	}{
+		{
+			matched: false,
+			order:   iceberg,
+		},
+		{
+			matched: true,
+			order:   createOrder("2", KindLimit, ConditionFOK, 8, *apd.New(2010, -2), apd.Decimal{}, SideBuy),
+		},
+	}
+	for _, tt := range tests {
+		matched, err := ob.Add(ctx, tt.order)
+		suite.NoError(err)
+		suite.Equal(tt.matched, matched)
+	}
+
+	trade := <-ob.TradeEvents
+	suite.Equal(int64(8), trade.Qty)
+
+	iceberg, ok := ob.findActiveOrder("1")
+	suite.True(ok)
+	suite.Equal(int64(8), iceberg.FilledQty)
+	suite.Equal(int64(2), iceberg.VisibleQty)
+	suite.Equal(0, ob.orders.Bids.Len())
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Iceberg_Reject_Invalid_DisplayQty() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	limit := createOrder("1", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideSell)
+	limit.DisplayQty = 6
+	market := createOrder("2", KindMarket, 0, 5, apd.Decimal{}, apd.Decimal{}, SideSell)
+	market.DisplayQty = 2
+
+	for _, order := range []Order{limit, market} {
+		_, err := ob.Add(ctx, order)
+		suite.ErrorIs(err, ErrInvalidDisplayQty)
+	}
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_PostOnly_Reject() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	report, err := ob.Place(ctx, createOrder("2", KindLimit, ConditionPostOnly, 5, *apd.New(2020, -2), apd.Decimal{}, SideBuy))
+	suite.ErrorIs(err, ErrPostOnlyWouldTake)
+	suite.False(report.Matched)
+
+	_, ok := ob.findActiveOrder("2")
+	suite.False(ok)
+	suite.Equal(0, ob.orders.Bids.Len())
+	suite.Equal(1, ob.orders.Asks.Len())
+	suite.Len(ob.TradeEvents, 0)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_PostOnly_Slide() {
+	ob := NewOrderBook(instrument, *apd.New(2025, -2), &NopRepository{}, WithTickSize(*apd.New(5, -2)))
+	ctx := context.Background()
+
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+
+	postOnly := createOrder("2", KindLimit, ConditionPostOnly, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell)
+	postOnly.PostOnlySlide = true
+	report, err := ob.Place(ctx, postOnly)
+	suite.NoError(err)
+	suite.False(report.Matched)
+	suite.True(report.Repriced)
+	suite.Equal(0, report.Order.Price.Cmp(apd.New(2015, -2)))
+
+	asks := ob.GetAsks()
+	suite.Len(asks, 1)
+	suite.Equal(0, asks[0].Price.Cmp(apd.New(2015, -2)))
+	suite.Len(ob.TradeEvents, 0)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_PostOnly_Slide_Behind_AON() {
+	ob := NewOrderBook(instrument, *apd.New(2025, -2), &NopRepository{}, WithInstrument(Instrument{
+		TickSize:         *apd.New(5, -2),
+		PriceBandPercent: *apd.New(1, 0),
+	}))
+	ob.SetReferencePrice(*apd.New(20, 0))
+	ctx := context.Background()
+
+	// the all-or-nothing order at the top is the best price even though it can't be filled by the post-only order
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, ConditionAON, 100, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("2", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	postOnly := createOrder("3", KindLimit, ConditionPostOnly, 5, *apd.New(2020, -2), apd.Decimal{}, SideBuy)
+	postOnly.PostOnlySlide = true
+	report, err := ob.Place(ctx, postOnly)
+	suite.NoError(err)
+	suite.True(report.Repriced)
+	suite.Equal(0, report.Order.Price.Cmp(apd.New(1995, -2)))
+	suite.Len(ob.TradeEvents, 0)
+
+	// the slid price is checked against the price band of 19.80 - 20.20
+	suite.Len(ob.MassCancel(ctx, CancelFilter{TickerSymbol: instrument}), 3)
+	_, err = ob.Add(ctx, createOrder("4", KindLimit, 0, 5, *apd.New(1980, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	postOnly = createOrder("5", KindLimit, ConditionPostOnly, 5, *apd.New(1980, -2), apd.Decimal{}, SideBuy)
+	postOnly.PostOnlySlide = true
+	_, err = ob.Place(ctx, postOnly)
+	suite.ErrorIs(err, ErrPriceOutsideBand)
+	_, ok := ob.findActiveOrder("5")
+	suite.False(ok)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_PostOnly_Rest_Without_Cross() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	report, err := ob.Place(ctx, createOrder("2", KindLimit, ConditionPostOnly, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.False(report.Matched)
+	suite.False(report.Repriced)
+	suite.Equal(1, ob.orders.Bids.Len())
+
+	_, err = ob.Add(ctx, createOrder("3", KindMarket, ConditionPostOnly, 5, apd.Decimal{}, apd.Decimal{}, SideBuy))
+	suite.ErrorIs(err, ErrInvalidPostOnly)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Peg_Follows_Best_Bid_Up_To_Cap() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	pegged := createOrder("3", KindLimit, 0, 5, apd.Decimal{}, apd.Decimal{}, SideBuy)
+	pegged.Peg = PegBestBid
+	pegged.PegOffset = *apd.New(1, -2)
+	pegged.PegCap = *apd.New(2005, -2)
+
+	orders := []Order{
+		createOrder("1", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+		createOrder("2", KindLimit, 0, 5, *apd.New(2050, -2), apd.Decimal{}, SideSell),
+		pegged,
+	}
+	for _, order := range orders {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+	pegged, _ = ob.findActiveOrder("3")
+	suite.Equal(0, pegged.Price.Cmp(apd.New(2001, -2)))
+
+	_, err := ob.Add(ctx, createOrder("4", KindLimit, 0, 5, *apd.New(2003, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	pegged, _ = ob.findActiveOrder("3")
+	suite.Equal(0, pegged.Price.Cmp(apd.New(2004, -2)))
+
+	_, err = ob.Add(ctx, createOrder("5", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	pegged, _ = ob.findActiveOrder("3")
+	suite.Equal(0, pegged.Price.Cmp(apd.New(2005, -2)))
+
+	// the cap is never traded through
+	matched, err := ob.Add(ctx, createOrder("6", KindLimit, 0, 15, *apd.New(2006, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	suite.True(matched)
+	pegged, _ = ob.findActiveOrder("3")
+	suite.Equal(int64(0), pegged.FilledQty)
+
+	ids := make([]string, 0)
+	for _, bid := range ob.GetBids() {
+		ids = append(ids, bid.ID)
+	}
+	suite.Equal([]string{"3", "4", "1"}, ids)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Peg_Midpoint_Rounded_Away_From_The_Spread() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	pegged := createOrder("3", KindLimit, 0, 5, apd.Decimal{}, apd.Decimal{}, SideSell)
+	pegged.Peg = PegMidpoint
+
+	orders := []Order{
+		createOrder("1", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+		createOrder("2", KindLimit, 0, 5, *apd.New(2005, -2), apd.Decimal{}, SideSell),
+		pegged,
+	}
+	for _, order := range orders {
+		matched, err := ob.Add(ctx, order)
+		suite.NoError(err)
+		suite.False(matched)
+	}
+
+	asks := ob.GetAsks()
+	suite.Len(asks, 2)
+	suite.Equal("3", asks[0].ID)
+	suite.Equal(0, asks[0].Price.Cmp(apd.New(2003, -2)))
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Peg_Reprice_Keeps_Time_Priority() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	first := createOrder("2", KindLimit, 0, 5, apd.Decimal{}, apd.Decimal{}, SideBuy)
+	first.Peg = PegBestBid
+	second := createOrder("3", KindLimit, 0, 5, apd.Decimal{}, apd.Decimal{}, SideBuy)
+	second.Peg = PegBestBid
+
+	orders := []Order{
+		createOrder("1", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+		first,
+		second,
+		createOrder("4", KindLimit, 0, 5, *apd.New(2002, -2), apd.Decimal{}, SideBuy),
+	}
+	for _, order := range orders {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+
+	ids := make([]string, 0)
+	for _, bid := range ob.GetBids() {
+		ids = append(ids, bid.ID)
+	}
+	suite.Equal([]string{"4", "2", "3", "1"}, ids)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Peg_Reject() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	pegged := createOrder("1", KindLimit, 0, 5, apd.Decimal{}, apd.Decimal{}, SideBuy)
+	pegged.Peg = PegBestAsk
+	_, err := ob.Add(ctx, pegged)
+	suite.ErrorIs(err, ErrPegPriceNotFound)
+
+	market := createOrder("2", KindMarket, 0, 5, apd.Decimal{}, apd.Decimal{}, SideBuy)
+	market.Peg = PegBestBid
+	_, err = ob.Add(ctx, market)
+	suite.ErrorIs(err, ErrInvalidPeg)
+}
+
+func (suite *orderBookTestSuite) addOCOLegs(ctx context.Context) {
+	takeProfit := createOrder("1", KindLimit, 0, 5, *apd.New(2100, -2), apd.Decimal{}, SideSell)
+	stopLoss := createOrder("2", KindMarket, ConditionStop, 5, apd.Decimal{}, *apd.New(1900, -2), SideSell)
+	for _, leg := range []*Order{&takeProfit, &stopLoss} {
+		leg.CustomerID = "customer"
+		leg.OCOGroupID = "group"
+		matched, err := suite.ob.Add(ctx, *leg)
+		suite.NoError(err)
+		suite.False(matched)
+	}
+	suite.Len(suite.ob.GetAsks(), 1)
+	suite.Len(suite.ob.GetStopAsks(), 1)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_OCO_Fill_Cancels_Stop_Leg() {
+	ob := suite.ob
+	ctx := context.Background()
+	suite.addOCOLegs(ctx)
+
+	matched, err := ob.Add(ctx, createOrder("3", KindLimit, 0, 2, *apd.New(2100, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.True(matched)
+
+	suite.Len(ob.GetStopAsks(), 0)
+	_, ok := ob.findActiveOrder("2")
+	suite.False(ok)
+
+	event := <-ob.Events
+	suite.Equal(EventTypeCancelled, event.Type)
+	suite.Equal("2", event.OrderID)
+
+	asks := ob.GetAsks()
+	suite.Len(asks, 1)
+	suite.Equal(int64(2), asks[0].FilledQty)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_OCO_Stop_Trigger_Cancels_Limit_Leg() {
+	ob := suite.ob
+	ctx := context.Background()
+	suite.addOCOLegs(ctx)
+
+	ob.SetMarketPrice(ctx, *apd.New(1900, -2))
+
+	event := <-ob.Events
+	suite.Equal(EventTypeCancelled, event.Type)
+	suite.Equal("1", event.OrderID)
+
+	suite.Len(ob.GetStopAsks(), 0)
+	asks := ob.GetAsks()
+	suite.Len(asks, 1)
+	suite.Equal("2", asks[0].ID)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_OCO_Cancel_Cancels_Group() {
+	ob := suite.ob
+	ctx := context.Background()
+	suite.addOCOLegs(ctx)
+
+	_, err := ob.Cancel(ctx, "1")
+	suite.NoError(err)
+
+	event := <-ob.Events
+	suite.Equal(EventTypeCancelled, event.Type)
+	suite.Equal("2", event.OrderID)
+	suite.Len(ob.GetStopAsks(), 0)
+
+	// another customer's group with the same ID is not affected
+	other := createOrder("3", KindLimit, 0, 5, *apd.New(2200, -2), apd.Decimal{}, SideSell)
+	other.OCOGroupID = "group"
+	_, err = ob.Add(ctx, other)
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("4", KindLimit, 0, 5, *apd.New(2200, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.Len(ob.Events, 0)
+}
+
+func (suite *orderBookTestSuite) newBracket(entry Order) Bracket {
+	takeProfit := createOrder("tp", KindLimit, 0, 0, *apd.New(2100, -2), apd.Decimal{}, SideSell)
+	stopLoss := createOrder("sl", KindMarket, ConditionStop, 0, apd.Decimal{}, *apd.New(1900, -2), SideSell)
+	return Bracket{
+		Entry:      entry,
+		TakeProfit: &takeProfit,
+		StopLoss:   &stopLoss,
+	}
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Bracket_Children_Sized_To_Partial_Fills() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	entry := createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	report, err := ob.PlaceBracket(ctx, suite.newBracket(entry))
+	suite.NoError(err)
+	suite.False(report.Matched)
+	suite.Len(ob.GetAsks(), 0)
+	suite.Len(ob.GetStopAsks(), 0)
+
+	_, err = ob.Add(ctx, createOrder("2", KindLimit, 0, 4, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	takeProfit, ok := ob.findActiveOrder("tp")
+	suite.True(ok)
+	suite.Equal(int64(4), takeProfit.Qty)
+	suite.Equal(entry.CustomerID, takeProfit.CustomerID)
+	stopLoss, ok := ob.findActiveOrder("sl")
+	suite.True(ok)
+	suite.Equal(int64(4), stopLoss.Qty)
+	suite.Len(ob.GetStopAsks(), 1)
+
+	_, err = ob.Add(ctx, createOrder("3", KindLimit, 0, 6, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	takeProfit, _ = ob.findActiveOrder("tp")
+	suite.Equal(int64(10), takeProfit.Qty)
+	stopLoss, _ = ob.findActiveOrder("sl")
+	suite.Equal(int64(10), stopLoss.Qty)
+	suite.Len(ob.brackets, 0)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Bracket_Take_Profit_Cancels_Stop_Loss() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	entry := createOrder("2", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	report, err := ob.PlaceBracket(ctx, suite.newBracket(entry))
+	suite.NoError(err)
+	suite.True(report.Matched)
+	suite.Len(ob.GetStopAsks(), 1)
+
+	asks := ob.GetAsks()
+	suite.Len(asks, 1)
+	suite.Equal("tp", asks[0].ID)
+	suite.Equal(int64(5), asks[0].Qty)
+
+	_, err = ob.Add(ctx, createOrder("3", KindLimit, 0, 5, *apd.New(2100, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+
+	event := <-ob.Events
+	suite.Equal(EventTypeCancelled, event.Type)
+	suite.Equal("sl", event.OrderID)
+	suite.Len(ob.GetStopAsks(), 0)
+	suite.Len(ob.GetAsks(), 0)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Bracket_Leg_Cancels_Entry_Rest() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	entry := createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	_, err := ob.PlaceBracket(ctx, suite.newBracket(entry))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("2", KindLimit, 0, 4, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	// the take-profit closes the position, the stop-loss and the rest of the entry are cancelled
+	_, err = ob.Add(ctx, createOrder("3", KindLimit, 0, 4, *apd.New(2100, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.Require().Len(ob.Events, 2)
+	for _, id := range []string{"sl", "1"} {
+		event := <-ob.Events
+		suite.Equal(EventTypeCancelled, event.Type)
+		suite.Equal(id, event.OrderID)
+	}
+	suite.Len(ob.brackets, 0)
+
+	// later sells don't fill the entry without protection
+	matched, err := ob.Add(ctx, createOrder("4", KindLimit, 0, 6, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	suite.False(matched)
+	suite.Len(ob.GetBids(), 0)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Bracket_Child_Below_Min_Qty() {
+	ob := NewOrderBook(instrument, *apd.New(2025, -2), &NopRepository{}, WithInstrument(Instrument{MinQty: 5}))
+	ctx := context.Background()
+
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 8, *apd.New(2000, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	entry := createOrder("2", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	_, err = ob.PlaceBracket(ctx, suite.newBracket(entry))
+	suite.NoError(err)
+
+	// the entry is filled by 2 behind the first bid and by 1 behind a better bid,
+	// children of 3 are below the min qty and wait for more fills
+	_, err = ob.Add(ctx, createOrder("3", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("4", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("5", KindLimit, 0, 6, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	suite.Len(ob.Events, 0)
+	suite.Len(ob.GetAsks(), 0)
+	suite.Len(ob.GetStopAsks(), 0)
+
+	// the children are placed once they cover the min qty and resized by the last fill
+	_, err = ob.Add(ctx, createOrder("6", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	takeProfit, ok := ob.findActiveOrder("tp")
+	suite.Require().True(ok)
+	suite.Equal(int64(8), takeProfit.Qty)
+	stopLoss, ok := ob.findActiveOrder("sl")
+	suite.Require().True(ok)
+	suite.Equal(int64(8), stopLoss.Qty)
+
+	_, err = ob.Add(ctx, createOrder("7", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	takeProfit, _ = ob.findActiveOrder("tp")
+	suite.Equal(int64(10), takeProfit.Qty)
+	stopLoss, _ = ob.findActiveOrder("sl")
+	suite.Equal(int64(10), stopLoss.Qty)
+	suite.Len(ob.brackets, 0)
+	suite.Len(ob.Events, 0)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Bracket_Entry_Finished_Below_Min_Qty() {
+	ob := NewOrderBook(instrument, *apd.New(2025, -2), &NopRepository{}, WithInstrument(Instrument{MinQty: 5}))
+	ctx := context.Background()
+
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 8, *apd.New(2000, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	entry := createOrder("2", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	_, err = ob.PlaceBracket(ctx, suite.newBracket(entry))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("3", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	// the entry is cancelled after a fill of 2, the children below the min qty are cancelled
+	_, err = ob.Cancel(ctx, "2")
+	suite.NoError(err)
+	suite.Require().Len(ob.Events, 2)
+	for _, id := range []string{"sl", "tp"} {
+		event := <-ob.Events
+		suite.Equal(EventTypeCancelled, event.Type)
+		suite.Equal(id, event.OrderID)
+	}
+	suite.Len(ob.brackets, 0)
+	suite.Len(ob.GetAsks(), 0)
+	suite.Len(ob.GetStopAsks(), 0)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Bracket_Reject() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	bracket := suite.newBracket(createOrder("1", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy))
+	bracket.TakeProfit.Side = SideBuy
+	_, err := ob.PlaceBracket(ctx, bracket)
+	suite.ErrorIs(err, ErrInvalidBracket)
+
+	bracket = suite.newBracket(createOrder("1", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy))
+	bracket.StopLoss.StopPrice = apd.Decimal{}
+	_, err = ob.PlaceBracket(ctx, bracket)
+	suite.ErrorIs(err, ErrInvalidStopPrice)
+	suite.Len(ob.brackets, 0)
+	suite.Len(ob.GetBids(), 0)
+}
+
+// memoryRepository keeps the latest version of every saved order
+type memoryRepository struct {
+	orders map[string]Order
+}
+
+func (r *memoryRepository) FindOrder(ctx context.Context, id string) (*Order, error) {
+	order, ok := r.orders[id]
+	if !ok {
+		return nil, nil
+	}
+	return &order, nil
+}
+
+func (r *memoryRepository) SaveOrder(ctx context.Context, order *Order) error {
+	r.orders[order.ID] = *order
+	return nil
+}
+
+// failingRepository fails to save the order until it is cancelled
+type failingRepository struct {
+	memoryRepository
+	failID string
+}
+
+func (r *failingRepository) SaveOrder(ctx context.Context, order *Order) error {
+	if order.ID == r.failID && !order.IsCancelled() {
+		return errors.New("repository unavailable")
+	}
+	return r.memoryRepository.SaveOrder(ctx, order)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Peg_Reprice_Failure_Cancels() {
+	repo := &failingRepository{memoryRepository: memoryRepository{orders: make(map[string]Order)}}
+	ob := NewOrderBook(instrument, *apd.New(2025, -2), repo)
+	ctx := context.Background()
+
+	pegged := createOrder("2", KindLimit, 0, 5, apd.Decimal{}, apd.Decimal{}, SideBuy)
+	pegged.Peg = PegBestBid
+	for _, order := range []Order{createOrder("1", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy), pegged} {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+
+	// the repriced order can't be stored, it is cancelled instead of lost
+	repo.failID = "2"
+	_, err := ob.Add(ctx, createOrder("3", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+
+	_, ok := ob.findActiveOrder("2")
+	suite.False(ok)
+	_, ok = ob.orders.Find("2")
+	suite.False(ok)
+	suite.True(repo.orders["2"].Cancelled)
+	suite.Require().Len(ob.Events, 1)
+	event := <-ob.Events
+	suite.Equal(EventTypeCancelled, event.Type)
+	suite.Equal("2", event.OrderID)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Cancel_Removes_From_Books() {
+	ob := NewOrderBook(instrument, *apd.New(2025, -2), &memoryRepository{orders: make(map[string]Order)})
+	ctx := context.Background()
+
+	orders := []Order{
+		createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+		createOrder("2", KindLimit, 0, 4, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+		createOrder("3", KindMarket, ConditionStop, 5, apd.Decimal{}, *apd.New(1900, -2), SideSell),
+	}
+	for _, order := range orders {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+
+	cancelled, err := ob.Cancel(ctx, "1")
+	suite.NoError(err)
+	suite.True(cancelled.IsCancelled())
+	suite.Equal(int64(4), cancelled.FilledQty)
+	suite.Equal(int64(6), cancelled.UnfilledQty())
+	suite.Equal(0, ob.orders.Len(SideBuy))
+
+	_, err = ob.Cancel(ctx, "3")
+	suite.NoError(err)
+	suite.Equal(0, ob.stopOrders.Len(SideSell))
+	suite.Len(ob.activeOrders, 0)
+
+	_, err = ob.Cancel(ctx, "1")
+	suite.ErrorIs(err, ErrOrderFinished)
+	_, err = ob.Cancel(ctx, "2")
+	suite.ErrorIs(err, ErrOrderFinished)
+	_, err = ob.Cancel(ctx, "4")
+	suite.ErrorIs(err, ErrOrderNotFound)
+}
+
+func (suite *orderBookTestSuite) bidIDs() []string {
+	ids := make([]string, 0)
+	for _, bid := range suite.ob.GetBids() {
+		ids = append(ids, bid.ID)
+	}
+	return ids
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Replace_Priority() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	orders := []Order{
+		createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+		createOrder("2", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+	}
+	for _, order := range orders {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+
+	// reducing the quantity keeps the time priority
+	report, err := ob.Replace(ctx, "1", 5, apd.Decimal{})
+	suite.NoError(err)
+	suite.False(report.Requeued)
+	suite.Equal(int64(5), report.Order.Qty)
+	suite.Equal([]string{"1", "2"}, suite.bidIDs())
+
+	// increasing the quantity loses it
+	report, err = ob.Replace(ctx, "1", 15, apd.Decimal{})
+	suite.NoError(err)
+	suite.True(report.Requeued)
+	suite.Equal([]string{"2", "1"}, suite.bidIDs())
+
+	// so does a price change, even to the same price level
+	report, err = ob.Replace(ctx, "2", 0, *apd.New(2001, -2))
+	suite.NoError(err)
+	suite.True(report.Requeued)
+	report, err = ob.Replace(ctx, "2", 0, *apd.New(2000, -2))
+	suite.NoError(err)
+	suite.Equal([]string{"1", "2"}, suite.bidIDs())
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Replace_Price_Crosses_The_Spread() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	orders := []Order{
+		createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+		createOrder("2", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideSell),
+	}
+	for _, order := range orders {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+
+	report, err := ob.Replace(ctx, "1", 0, *apd.New(2010, -2))
+	suite.NoError(err)
+	suite.True(report.Matched)
+	suite.Equal(int64(5), report.Order.FilledQty)
+	suite.Len(ob.GetAsks(), 0)
+
+	bids := ob.GetBids()
+	suite.Len(bids, 1)
+	suite.Equal(0, bids[0].Price.Cmp(apd.New(2010, -2)))
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Replace_Never_Overfills() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	orders := []Order{
+		createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+		createOrder("2", KindLimit, 0, 4, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+	}
+	for _, order := range orders {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+
+	for _, qty := range []int64{3, 4} {
+		_, err := ob.Replace(ctx, "1", qty, apd.Decimal{})
+		suite.ErrorIs(err, ErrInvalidQty)
+	}
+	order, _ := ob.findActiveOrder("1")
+	suite.Equal(int64(10), order.Qty)
+
+	report, err := ob.Replace(ctx, "1", 5, apd.Decimal{})
+	suite.NoError(err)
+	suite.Equal(int64(1), report.Order.UnfilledQty())
+
+	_, err = ob.Replace(ctx, "3", 5, apd.Decimal{})
+	suite.ErrorIs(err, ErrOrderNotFound)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_MassCancel() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	orders := []Order{
+		createOrder("1", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+		createOrder("2", KindLimit, 0, 5, *apd.New(2100, -2), apd.Decimal{}, SideSell),
+		createOrder("3", KindMarket, ConditionStop, 5, apd.Decimal{}, *apd.New(1900, -2), SideSell),
+		createOrder("4", KindLimit, 0, 5, *apd.New(2200, -2), apd.Decimal{}, SideSell),
+		createOrder("5", KindLimit, 0, 5, *apd.New(1950, -2), apd.Decimal{}, SideBuy),
+		createOrder("6", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideBuy),
+	}
+	for i := range orders[:5] {
+		orders[i].CustomerID = "algo"
+	}
+	orders[3].OCOGroupID = "group"
+	orders[4].OCOGroupID = "group"
+	for _, order := range orders {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+
+	results := ob.MassCancel(ctx, CancelFilter{CustomerID: "algo", Side: SideSell})
+	suite.Len(results, 3)
+	for _, result := range results {
+		suite.NoError(result.Err)
+		suite.True(result.Order.IsCancelled())
+	}
+	suite.Equal("5", (<-ob.Events).OrderID) // cancelled as the OCO sibling of 4
+	suite.Len(ob.GetAsks(), 0)
+	suite.Len(ob.GetStopAsks(), 0)
+	suite.Equal([]string{"6", "1"}, suite.bidIDs())
+
+	results = ob.MassCancel(ctx, CancelFilter{CustomerID: "algo", TickerSymbol: instrument})
+	suite.Len(results, 1)
+	suite.Equal("1", results[0].Order.ID)
+	suite.Equal([]string{"6"}, suite.bidIDs())
+
+	suite.Len(ob.MassCancel(ctx, CancelFilter{TickerSymbol: "OTHER"}), 0)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_SelfTradePrevention() {
+	tests := []struct {
+		mode          STPMode
+		restingQty    int64
+		incomingQty   int64
+		restingLeft   int64 // unfilled quantity of the resting order left in the books, zero if cancelled
+		incomingLeft  int64 // unfilled quantity of the incoming order rested in the books, zero if cancelled
+		cancelledIDs  []string
+		decrementedID string
+	}{
+		{mode: STPCancelNewest, restingQty: 5, incomingQty: 5, restingLeft: 5, cancelledIDs: []string{"2"}},
+		{mode: STPCancelOldest, restingQty: 5, incomingQty: 5, incomingLeft: 5, cancelledIDs: []string{"1"}},
+		{mode: STPCancelBoth, restingQty: 5, incomingQty: 5, cancelledIDs: []string{"1", "2"}},
+		{mode: STPDecrementAndCancel, restingQty: 8, incomingQty: 5, restingLeft: 3, cancelledIDs: []string{"2"}, decrementedID: "1"},
+		{mode: STPDecrementAndCancel, restingQty: 5, incomingQty: 8, incomingLeft: 3, cancelledIDs: []string{"1"}, decrementedID: "2"},
+	}
+	for _, tt := range tests {
+		suite.SetupTest()
+		ob := suite.ob
+		ctx := context.Background()
+
+		resting := createOrder("1", KindLimit, 0, tt.restingQty, *apd.New(2000, -2), apd.Decimal{}, SideSell)
+		resting.CustomerID = "wash"
+		incoming := createOrder("2", KindLimit, 0, tt.incomingQty, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+		incoming.STPGroupID = "desk"
+		incoming.STPMode = tt.mode
+		resting.STPGroupID = "desk"
+
+		_, err := ob.Add(ctx, resting)
+		suite.NoError(err)
+		matched, err := ob.Add(ctx, incoming)
+		suite.NoError(err)
+		suite.False(matched, tt.mode.String())
+		suite.Len(ob.TradeEvents, 0)
+
+		asks := ob.GetAsks()
+		if tt.restingLeft > 0 {
+			suite.Len(asks, 1)
+			suite.Equal(tt.restingLeft, asks[0].UnfilledQty())
+		} else {
+			suite.Len(asks, 0)
+		}
+		bids := ob.GetBids()
+		if tt.incomingLeft > 0 {
+			suite.Len(bids, 1)
+			suite.Equal(tt.incomingLeft, bids[0].UnfilledQty())
+		} else {
+			suite.Len(bids, 0)
+		}
+
+		ids := make([]string, 0)
+		for len(ob.Events) > 0 {
+			event := <-ob.Events
+			suite.Equal(EventTypeSelfTradePrevented, event.Type)
+			suite.Equal(tt.mode.String(), event.Reason)
+			ids = append(ids, event.OrderID)
+		}
+		expected := tt.cancelledIDs
+		if tt.decrementedID != "" {
+			expected = append([]string{tt.decrementedID}, expected...)
+		}
+		suite.ElementsMatch(expected, ids, tt.mode.String())
+	}
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_SelfTradePrevention_Other_Customer_Trades() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	incoming := createOrder("2", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	incoming.STPMode = STPCancelBoth
+	matched, err := ob.Add(ctx, incoming)
+	suite.NoError(err)
+	suite.True(matched)
+	suite.Len(ob.Events, 0)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_ClientOrderID() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	first := createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	first.ClientOrderID = "c-1"
+	_, err := ob.Add(ctx, first)
+	suite.NoError(err)
+
+	found, ok := ob.FindClientOrder(first.CustomerID, "c-1")
+	suite.True(ok)
+	suite.Equal("1", found.ID)
+	_, ok = ob.FindClientOrder("other", "c-1")
+	suite.False(ok)
+
+	// the client order id is unique among the resting orders of the customer
+	duplicate := createOrder("2", KindLimit, 0, 5, *apd.New(1900, -2), apd.Decimal{}, SideBuy)
+	duplicate.CustomerID = first.CustomerID
+	duplicate.ClientOrderID = "c-1"
+	_, err = ob.Add(ctx, duplicate)
+	suite.ErrorIs(err, ErrDuplicateClientID)
+
+	// once the order has left the books the client order id can be used again
+	_, err = ob.Cancel(ctx, "1")
+	suite.NoError(err)
+	_, ok = ob.FindClientOrder(first.CustomerID, "c-1")
+	suite.False(ok)
+	_, err = ob.Add(ctx, duplicate)
+	suite.NoError(err)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Instrument_Validation() {
+	ob := NewOrderBook(instrument, *apd.New(2025, -2), &NopRepository{}, WithInstrument(Instrument{
+		TickSize:    *apd.New(5, -2),
+		LotSize:     10,
+		MinQty:      20,
+		MaxQty:      1000,
+		MinNotional: *apd.New(500, 0),
+		MaxNotional: *apd.New(10000, 0),
+	}))
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		order Order
+		err   error
+	}{
+		{
+			name:  "price off the tick",
+			order: createOrder("1", KindLimit, 0, 50, *apd.New(2002, -2), apd.Decimal{}, SideBuy),
+			err:   ErrInvalidTickSize,
+		},
+		{
+			name:  "stop price off the tick",
+			order: createOrder("2", KindMarket, ConditionStop, 50, apd.Decimal{}, *apd.New(1901, -2), SideSell),
+			err:   ErrInvalidTickSize,
+		},
+		{
+			name:  "qty not a multiple of the lot size",
+			order: createOrder("3", KindLimit, 0, 55, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+			err:   ErrInvalidLotSize,
+		},
+		{
+			name:  "qty below the minimum",
+			order: createOrder("4", KindMarket, 0, 10, apd.Decimal{}, apd.Decimal{}, SideBuy),
+			err:   ErrQtyBelowMin,
+		},
+		{
+			name:  "qty above the maximum",
+			order: createOrder("5", KindMarket, 0, 1010, apd.Decimal{}, apd.Decimal{}, SideBuy),
+			err:   ErrQtyAboveMax,
+		},
+		{
+			name:  "notional below the minimum",
+			order: createOrder("6", KindLimit, 0, 20, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+			err:   ErrNotionalBelowMin,
+		},
+		{
+			name:  "notional above the maximum",
+			order: createOrder("7", KindLimit, 0, 600, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+			err:   ErrNotionalAboveMax,
+		},
+		{
+			name:  "valid",
+			order: createOrder("8", KindLimit, 0, 50, *apd.New(2005, -2), apd.Decimal{}, SideBuy),
+		},
+	}
+	for _, tt := range tests {
+		_, err := ob.Add(ctx, tt.order)
+		if tt.err == nil {
+			suite.NoError(err, tt.name)
+			continue
+		}
+		suite.ErrorIs(err, tt.err, tt.name)
+	}
+
+	// amends are validated as well
+	_, err := ob.Replace(ctx, "8", 0, *apd.New(2003, -2))
+	suite.ErrorIs(err, ErrInvalidTickSize)
+	_, err = ob.Replace(ctx, "8", 45, apd.Decimal{})
+	suite.ErrorIs(err, ErrInvalidLotSize)
+	_, err = ob.Replace(ctx, "8", 40, apd.Decimal{})
+	suite.NoError(err)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_TickLadder() {
+	books, err := (&BooksFactory{Mode: "config", OrderRepo: &NopRepository{}, Books: []BookConfig{
+		{
+			Symbol:      instrument,
+			MarketPrice: *apd.New(2025, -2),
+			Instrument: Instrument{
+				TickSize: *apd.New(1, -1),
+				TickLadder: []TickLevel{
+					{Below: *apd.New(10, 0), TickSize: *apd.New(1, -2)},
+					{Below: *apd.New(50, 0), TickSize: *apd.New(5, -2)},
+				},
+			},
+		},
+	}}).Create()
+	suite.NoError(err)
+	ob := books[instrument]
+	ctx := context.Background()
+
+	prices := []struct {
+		price apd.Decimal
+		err   error
+	}{
+		{price: *apd.New(9995, -3), err: ErrInvalidTickSize},
+		{price: *apd.New(999, -2)},
+		{price: *apd.New(1002, -2), err: ErrInvalidTickSize},
+		{price: *apd.New(1005, -2)},
+		{price: *apd.New(5005, -2), err: ErrInvalidTickSize},
+		{price: *apd.New(501, -1)},
+	}
+	for i, tt := range prices {
+		_, err := ob.Add(ctx, createOrder(fmt.Sprint("tick-", i), KindLimit, 0, 5, tt.price, apd.Decimal{}, SideBuy))
+		if tt.err == nil {
+			suite.NoError(err, tt.price.String())
+			continue
+		}
+		suite.ErrorIs(err, tt.err, tt.price.String())
+	}
+
+	// the post-only order slides by the tick size of the step behind the opposite best price
+	suite.Len(ob.MassCancel(ctx, CancelFilter{TickerSymbol: instrument}), 3)
+	_, err = ob.Add(ctx, createOrder("1", KindLimit, 0, 5, *apd.New(10, 0), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	postOnly := createOrder("2", KindLimit, ConditionPostOnly, 5, *apd.New(1005, -2), apd.Decimal{}, SideBuy)
+	postOnly.PostOnlySlide = true
+	report, err := ob.Place(ctx, postOnly)
+	suite.NoError(err)
+	suite.True(report.Repriced)
+	suite.Equal(0, report.Order.Price.Cmp(apd.New(999, -2)))
+
+	// the pegged order is rounded to the tick size of its step
+	pegged := createOrder("3", KindLimit, 0, 5, apd.Decimal{}, apd.Decimal{}, SideSell)
+	pegged.Peg = PegMidpoint
+	report, err = ob.Place(ctx, pegged)
+	suite.NoError(err)
+	suite.Equal(0, report.Order.Price.Cmp(apd.New(10, 0)))
+
+	_, err = (&BooksFactory{Mode: "config", OrderRepo: &NopRepository{}, Books: []BookConfig{
+		{
+			Symbol: instrument,
+			Instrument: Instrument{
+				TickLadder: []TickLevel{
+					{Below: *apd.New(50, 0), TickSize: *apd.New(5, -2)},
+					{Below: *apd.New(10, 0), TickSize: *apd.New(1, -2)},
+				},
+			},
+		},
+	}}).Create()
+	suite.ErrorIs(err, ErrInvalidInstrument)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_PriceBand() {
+	for _, policy := range []BandPolicy{BandPolicyCancel, BandPolicyLimit} {
+		ob := NewOrderBook(instrument, *apd.New(20, 0), &NopRepository{}, WithInstrument(Instrument{
+			PriceBandPercent: *apd.New(10, 0),
+			PriceBandPolicy:  policy,
+		}))
+		ctx := context.Background()
+
+		// the band follows the market price of 20
+		_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 5, *apd.New(2250, -2), apd.Decimal{}, SideBuy))
+		suite.ErrorIs(err, ErrPriceOutsideBand)
+		_, err = ob.Add(ctx, createOrder("2", KindLimit, 0, 5, *apd.New(21, 0), apd.Decimal{}, SideSell))
+		suite.NoError(err)
+		_, err = ob.Add(ctx, createOrder("3", KindLimit, 0, 5, *apd.New(22, 0), apd.Decimal{}, SideSell))
+		suite.NoError(err)
+
+		// the previous close moves the band to 17.55 - 21.45, the market order doesn't sweep the ask at 22
+		ob.SetReferencePrice(*apd.New(195, -1))
+		report, err := ob.Place(ctx, createOrder("4", KindMarket, 0, 10, apd.Decimal{}, apd.Decimal{}, SideBuy))
+		suite.NoError(err)
+		suite.True(report.Matched)
+		suite.Equal(int64(5), report.Order.FilledQty)
+		suite.Len(ob.TradeEvents, 1)
+		suite.Len(ob.Events, 1)
+		event := <-ob.Events
+		suite.Equal(EventTypePriceBandBreached, event.Type)
+		suite.Equal("4", event.OrderID)
+
+		switch policy {
+		case BandPolicyCancel:
+			suite.True(report.Order.IsCancelled())
+			suite.Len(ob.GetBids(), 0)
+		case BandPolicyLimit:
+			suite.False(report.Order.IsCancelled())
+			suite.Equal(KindLimit, report.Order.Kind)
+			suite.Equal(0, report.Order.Price.Cmp(apd.New(2145, -2)))
+			bids := ob.GetBids()
+			suite.Len(bids, 1)
+			suite.Equal("4", bids[0].ID)
+		}
+		suite.Len(ob.GetAsks(), 1)
+	}
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Halt_Resume() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("2", KindLimit, 0, 10, *apd.New(1990, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("3", KindMarket, ConditionStop, 5, apd.Decimal{}, *apd.New(1900, -2), SideSell))
+	suite.NoError(err)
+
+	_, err = ob.Resume(ctx, 0)
+	suite.ErrorIs(err, ErrNotHalted)
+
+	ob.Halt(ctx, "news pending")
+	suite.True(ob.TradingStatus().Halted)
+	_, err = ob.Add(ctx, createOrder("4", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.ErrorIs(err, ErrTradingHalted)
+	suite.ErrorContains(err, "news pending")
+
+	// cancels and reductions are accepted, nothing is matched
+	_, err = ob.Cancel(ctx, "2")
+	suite.NoError(err)
+	_, err = ob.Replace(ctx, "1", 8, apd.Decimal{})
+	suite.NoError(err)
+	_, err = ob.Replace(ctx, "1", 0, *apd.New(2010, -2))
+	suite.ErrorIs(err, ErrTradingHalted)
+	ob.SetMarketPrice(ctx, *apd.New(1800, -2))
+	suite.Len(ob.TradeEvents, 0)
+
+	// orders are collected without matching while re-opening, IOC orders are rejected
+	status, err := ob.Resume(ctx, 20*time.Millisecond)
+	suite.NoError(err)
+	suite.True(status.Halted)
+	suite.False(status.ResumeAt.IsZero())
+	_, err = ob.Add(ctx, createOrder("4", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("5", KindLimit, ConditionIOC, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.ErrorIs(err, ErrNotContinuous)
+	suite.Len(ob.GetAsks(), 1)
+	suite.Len(ob.TradeEvents, 0)
+
+	// the stop order crossed during the halt is triggered on resume
+	suite.Eventually(func() bool { return !ob.TradingStatus().Halted }, time.Second, 5*time.Millisecond)
+	suite.Len(ob.TradeEvents, 1)
+	trade := <-ob.TradeEvents
+	suite.Equal("1", trade.BidOrderID)
+	suite.Equal("3", trade.AskOrderID)
+
+	_, err = ob.Add(ctx, createOrder("6", KindLimit, 0, 3, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	suite.Len(ob.TradeEvents, 1)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Halt_Reopening_Auction() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	ob.Halt(ctx, "news pending")
+	_, err = ob.Resume(ctx, 20*time.Millisecond)
+	suite.NoError(err)
+
+	// the crossing order is accepted and rests until the re-opening ends
+	report, err := ob.Place(ctx, createOrder("2", KindLimit, 0, 5, *apd.New(1990, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	suite.False(report.Matched)
+	suite.Len(ob.GetAsks(), 1)
+	suite.Len(ob.TradeEvents, 0)
+
+	// the collected orders are uncrossed in an auction once trading resumes
+	suite.Eventually(func() bool { return !ob.TradingStatus().Halted }, time.Second, 5*time.Millisecond)
+	suite.Require().Len(ob.TradeEvents, 1)
+	trade := <-ob.TradeEvents
+	suite.True(trade.Auction)
+	suite.Equal("1", trade.BidOrderID)
+	suite.Equal("2", trade.AskOrderID)
+	suite.Equal(int64(5), trade.Qty)
+	suite.Len(ob.GetAsks(), 0)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Phases() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	suite.Equal(PhaseContinuous, ob.Phase().Phase)
+	suite.ErrorIs(ob.SetPhase(ctx, Phase(9)), ErrInvalidPhase)
+
+	// orders are collected without matching in the pre-open, IOC and FOK orders are rejected
+	suite.NoError(ob.SetPhase(ctx, PhasePreOpen))
+	event := <-ob.Events
+	suite.Equal(EventTypePhaseChanged, event.Type)
+	suite.Equal("CONTINUOUS -> PRE_OPEN", event.Reason)
+
+	_, err := ob.Add(ctx, createOrder("1", KindLimit, 0, 10, *apd.New(2010, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("2", KindLimit, ConditionGFD, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("3", KindLimit, ConditionIOC, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.ErrorIs(err, ErrNotContinuous)
+	suite.Len(ob.TradeEvents, 0)
+	suite.Len(ob.GetBids(), 1)
+	suite.Len(ob.GetAsks(), 1)
+	event = <-ob.Events
+	suite.Equal(EventTypeIndicativeAuction, event.Type)
+	suite.Equal(int64(5), event.Qty)
+
+	// the collected orders are matched in the opening auction
+	suite.NoError(ob.SetPhase(ctx, PhaseContinuous))
+	event = <-ob.Events
+	suite.Equal(EventTypePhaseChanged, event.Type)
+	suite.Len(ob.TradeEvents, 1)
+	trade := <-ob.TradeEvents
+	suite.Equal("1", trade.BidOrderID)
+	suite.Equal("2", trade.AskOrderID)
+	suite.Equal(int64(5), trade.Qty)
+	suite.True(trade.Auction)
+
+	// good-for-day orders are expired at the close, new orders are rejected
+	_, err = ob.Add(ctx, createOrder("4", KindLimit, ConditionGFD, 5, *apd.New(1990, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.NoError(ob.SetPhase(ctx, PhaseClosed))
+	event = <-ob.Events
+	suite.Equal("CONTINUOUS -> CLOSED", event.Reason)
+	event = <-ob.Events
+	suite.Equal(EventTypeExpired, event.Type)
+	suite.Equal("4", event.OrderID)
+	suite.Len(ob.GetBids(), 1)
+
+	_, err = ob.Add(ctx, createOrder("5", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.ErrorIs(err, ErrMarketClosed)
+	_, err = ob.Replace(ctx, "1", 0, *apd.New(2020, -2))
+	suite.ErrorIs(err, ErrMarketClosed)
+}
+
+func (suite *orderBookTestSuite) TestSchedule_PhaseAt() {
+	schedule := Schedule{
+		PreOpen:    8 * time.Hour,
+		Continuous: 9 * time.Hour,
+		Closing:    16 * time.Hour,
+		Closed:     16*time.Hour + 10*time.Minute,
+	}
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		at    time.Duration
+		phase Phase
+		next  time.Time
+	}{
+		{at: 7 * time.Hour, phase: PhaseClosed, next: day.Add(8 * time.Hour)},
+		{at: 8 * time.Hour, phase: PhasePreOpen, next: day.Add(9 * time.Hour)},
+		{at: 12 * time.Hour, phase: PhaseContinuous, next: day.Add(16 * time.Hour)},
+		{at: 16*time.Hour + 5*time.Minute, phase: PhaseClosing, next: day.Add(16*time.Hour + 10*time.Minute)},
+		{at: 20 * time.Hour, phase: PhaseClosed, next: day.AddDate(0, 0, 1).Add(8 * time.Hour)},
+	}
+	for _, tt := range tests {
+		phase, next := schedule.phaseAt(day.Add(tt.at))
+		suite.Equal(tt.phase, phase, tt.at.String())
+		suite.Equal(tt.next, next, tt.at.String())
+	}
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Auction_Price() {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		reference apd.Decimal
+		orders    []Order
+		price     apd.Decimal
+		volume    int64
+		imbalance int64
+	}{
+		{
+			name: "maximum volume",
+			orders: []Order{
+				createOrder("1", KindLimit, 0, 10, *apd.New(2020, -2), apd.Decimal{}, SideBuy),
+				createOrder("2", KindLimit, 0, 10, *apd.New(2010, -2), apd.Decimal{}, SideBuy),
+				createOrder("3", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+				createOrder("4", KindLimit, 0, 15, *apd.New(1990, -2), apd.Decimal{}, SideSell),
+				createOrder("5", KindLimit, 0, 10, *apd.New(2010, -2), apd.Decimal{}, SideSell),
+				createOrder("6", KindLimit, 0, 10, *apd.New(2030, -2), apd.Decimal{}, SideSell),
+			},
+			price:     *apd.New(2010, -2),
+			volume:    20,
+			imbalance: -5,
+		},
+		{
+			name: "buy pressure",
+			orders: []Order{
+				createOrder("1", KindLimit, 0, 10, *apd.New(2010, -2), apd.Decimal{}, SideBuy),
+				createOrder("2", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+			},
+			price:     *apd.New(2010, -2),
+			volume:    5,
+			imbalance: 5,
+		},
+		{
+			name: "sell pressure",
+			orders: []Order{
+				createOrder("1", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideBuy),
+				createOrder("2", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+			},
+			price:     *apd.New(2000, -2),
+			volume:    5,
+			imbalance: -5,
+		},
+		{
+			name:      "closest to the reference price",
+			reference: *apd.New(2002, -2),
+			orders: []Order{
+				createOrder("1", KindLimit, 0, 5, *apd.New(2010, -2), apd.Decimal{}, SideBuy),
+				createOrder("2", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+			},
+			price:  *apd.New(2000, -2),
+			volume: 5,
+		},
+		{
+			name: "market orders at the reference price",
+			orders: []Order{
+				createOrder("1", KindMarket, 0, 5, apd.Decimal{}, apd.Decimal{}, SideBuy),
+				createOrder("2", KindMarket, 0, 8, apd.Decimal{}, apd.Decimal{}, SideSell),
+			},
+			price:     *apd.New(2025, -2),
+			volume:    5,
+			imbalance: -3,
+		},
+	}
+
+	for _, tt := range tests {
+		ob := NewOrderBook(instrument, *apd.New(2025, -2), &NopRepository{})
+		ob.SetReferencePrice(tt.reference)
+		suite.NoError(ob.SetPhase(ctx, PhasePreOpen), tt.name)
+		for _, order := range tt.orders {
+			_, err := ob.Add(ctx, order)
+			suite.NoError(err, tt.name)
+		}
+		suite.Len(ob.TradeEvents, 0, tt.name)
+
+		result, ok, err := ob.IndicativeAuction()
+		suite.NoError(err, tt.name)
+		suite.True(ok, tt.name)
+		suite.Equal(0, result.Price.Cmp(&tt.price), "%s price %s", tt.name, &result.Price)
+		suite.Equal(tt.volume, result.Volume, tt.name)
+		suite.Equal(tt.imbalance, result.Imbalance, tt.name)
+	}
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Auction_Uncross() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	suite.NoError(ob.SetPhase(ctx, PhasePreOpen))
+	<-ob.Events
+	orders := []Order{
+		createOrder("1", KindLimit, 0, 10, *apd.New(2020, -2), apd.Decimal{}, SideBuy),
+		createOrder("2", KindLimit, 0, 10, *apd.New(2010, -2), apd.Decimal{}, SideBuy),
+		createOrder("3", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy),
+		createOrder("4", KindLimit, 0, 15, *apd.New(1990, -2), apd.Decimal{}, SideSell),
+		createOrder("5", KindLimit, 0, 10, *apd.New(2010, -2), apd.Decimal{}, SideSell),
+		createOrder("6", KindMarket, 0, 30, apd.Decimal{}, apd.Decimal{}, SideSell),
+	}
+	for _, order := range orders {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+
+	// the indicative price and volume are published while orders are collected
+	var indicative Event
+	for len(ob.Events) > 0 {
+		indicative = <-ob.Events
+		suite.Equal(EventTypeIndicativeAuction, indicative.Type)
+	}
+	suite.Equal(int64(30), indicative.Qty)
+	suite.Equal("19.90", indicative.Price.String())
+	suite.Len(ob.TradeEvents, 0)
+
+	// the market order makes a surplus of the asks at 19.90 and 20.00, every crossing order is executed at the lower price in its priority
+	suite.NoError(ob.SetPhase(ctx, PhaseContinuous))
+	expected := []struct {
+		bid, ask string
+		qty      int64
+	}{
+		{bid: "1", ask: "6", qty: 10},
+		{bid: "2", ask: "6", qty: 10},
+		{bid: "3", ask: "6", qty: 10},
+	}
+	suite.Len(ob.TradeEvents, len(expected))
+	for _, e := range expected {
+		trade := <-ob.TradeEvents
+		suite.True(trade.Auction)
+		suite.Equal("19.90", trade.Price.String())
+		suite.Equal(e.bid, trade.BidOrderID)
+		suite.Equal(e.ask, trade.AskOrderID)
+		suite.Equal(e.qty, trade.Qty)
+	}
+	marketPrice := ob.MarketPrice()
+	suite.Equal("19.90", marketPrice.String())
+	suite.Len(ob.GetBids(), 0)
+	suite.Len(ob.GetAsks(), 2)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Auction_Self_Trade() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	suite.NoError(ob.SetPhase(ctx, PhasePreOpen))
+	bid := createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	bid.CustomerID = "wash"
+	bid.STPMode = STPCancelNewest
+	wash := createOrder("2", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell)
+	wash.CustomerID = "wash"
+	wash.STPMode = STPCancelNewest
+	wash.CreatedAt = bid.CreatedAt.Add(time.Millisecond)
+	other := createOrder("3", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell)
+	other.CreatedAt = wash.CreatedAt.Add(time.Millisecond)
+	for _, order := range []Order{bid, wash, other} {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+	for len(ob.Events) > 0 {
+		<-ob.Events
+	}
+
+	// the newer ask of the same customer is cancelled instead of trading, the bid trades with the other customer
+	suite.NoError(ob.SetPhase(ctx, PhaseContinuous))
+	suite.Len(ob.TradeEvents, 1)
+	trade := <-ob.TradeEvents
+	suite.Equal("1", trade.BidOrderID)
+	suite.Equal("3", trade.AskOrderID)
+	suite.Equal(int64(5), trade.Qty)
+
+	prevented := make([]string, 0)
+	for len(ob.Events) > 0 {
+		if event := <-ob.Events; event.Type == EventTypeSelfTradePrevented {
+			prevented = append(prevented, event.OrderID)
+		}
+	}
+	suite.Equal([]string{"2"}, prevented)
+	suite.Len(ob.GetAsks(), 0)
+	bids := ob.GetBids()
+	suite.Len(bids, 1)
+	suite.Equal(int64(5), bids[0].UnfilledQty())
+}
+
+func (suite *orderBookTestSuite) TestMatchingAlgorithm_Allocate() {
+	tests := []struct {
+		name      string
+		algorithm MatchingAlgorithm
+		qty       int64
+		level     []Resting
+		shares    []int64
+	}{
+		{
+			name:      "fifo",
+			algorithm: FIFO{},
+			qty:       15,
+			level:     []Resting{{Qty: 10}, {Qty: 10}},
+			shares:    []int64{10, 5},
+		},
+		{
+			name:      "fifo with lmm",
+			algorithm: FIFO{LMM: LMM{Customers: []string{"mm"}, Percent: 40}},
+			qty:       10,
+			level:     []Resting{{CustomerID: "a", Qty: 10}, {CustomerID: "mm", Qty: 10}},
+			shares:    []int64{6, 4},
+		},
+		{
+			name:      "pro-rata rounds down, the rest in time priority",
+			algorithm: ProRata{},
+			qty:       10,
+			level:     []Resting{{Qty: 30}, {Qty: 10}},
+			shares:    []int64{8, 2},
+		},
+		{
+			name:      "pro-rata min allocation",
+			algorithm: ProRata{MinAllocation: 3},
+			qty:       10,
+			level:     []Resting{{Qty: 30}, {Qty: 10}},
+			shares:    []int64{10, 0},
+		},
+		{
+			name:      "pro-rata top order",
+			algorithm: ProRata{TopOrder: true},
+			qty:       20,
+			level:     []Resting{{Qty: 5}, {Qty: 30}, {Qty: 10}},
+			shares:    []int64{5, 12, 3},
+		},
+		{
+			name:      "pro-rata with lmm",
+			algorithm: ProRata{LMM: LMM{Customers: []string{"mm"}, Percent: 50}},
+			qty:       10,
+			level:     []Resting{{CustomerID: "a", Qty: 10}, {CustomerID: "mm", Qty: 10}},
+			shares:    []int64{4, 6},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Equal(tt.shares, tt.algorithm.Allocate(tt.qty, tt.level), tt.name)
+	}
+
+	_, err := ParseMatchingAlgorithm("lottery", false, 0, LMM{})
+	suite.ErrorIs(err, ErrInvalidInstrument)
+	_, err = ParseMatchingAlgorithm("pro-rata", false, 0, LMM{Percent: 120})
+	suite.ErrorIs(err, ErrInvalidInstrument)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_ProRata() {
+	ob := NewOrderBook(instrument, *apd.New(2025, -2), &NopRepository{}, WithMatchingAlgorithm(ProRata{}))
+	ctx := context.Background()
+
+	orders := []Order{
+		createOrder("1", KindLimit, 0, 30, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+		createOrder("2", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+		createOrder("3", KindLimit, ConditionAON, 20, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+		createOrder("4", KindLimit, 0, 10, *apd.New(2010, -2), apd.Decimal{}, SideSell),
+	}
+	for _, order := range orders {
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+
+	// the level is allocated pro-rata, the all-or-nothing order can't take a share
+	_, err := ob.Add(ctx, createOrder("5", KindLimit, 0, 10, *apd.New(2010, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.Require().Len(ob.TradeEvents, 2)
+	for _, expected := range []struct {
+		ask string
+		qty int64
+	}{{ask: "1", qty: 8}, {ask: "2", qty: 2}} {
+		trade := <-ob.TradeEvents
+		suite.Equal(expected.ask, trade.AskOrderID)
+		suite.Equal(expected.qty, trade.Qty)
+	}
+
+	// the whole level is filled, the next level is matched
+	_, err = ob.Add(ctx, createOrder("6", KindLimit, 0, 55, *apd.New(2010, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.Require().Len(ob.TradeEvents, 4)
+	for _, expected := range []struct {
+		ask string
+		qty int64
+	}{{ask: "1", qty: 22}, {ask: "2", qty: 8}, {ask: "3", qty: 20}, {ask: "4", qty: 5}} {
+		trade := <-ob.TradeEvents
+		suite.Equal(expected.ask, trade.AskOrderID)
+		suite.Equal(expected.qty, trade.Qty)
+	}
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_ProRata_Self_Trade_Outside_Allocation() {
+	ob := NewOrderBook(instrument, *apd.New(2025, -2), &NopRepository{}, WithMatchingAlgorithm(ProRata{}))
+	ctx := context.Background()
+
+	for _, order := range []Order{
+		createOrder("1", KindLimit, 0, 30, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+		createOrder("2", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+		createOrder("3", KindLimit, 0, 2, *apd.New(2000, -2), apd.Decimal{}, SideSell),
+	} {
+		if order.ID == "3" {
+			order.CustomerID = "wash"
+		}
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+
+	// the order of the same customer gets no share of 10, it neither cancels the incoming order nor is cancelled
+	incoming := createOrder("4", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	incoming.CustomerID = "wash"
+	incoming.STPMode = STPCancelBoth
+	matched, err := ob.Add(ctx, incoming)
+	suite.NoError(err)
+	suite.True(matched)
+	suite.Require().Len(ob.TradeEvents, 2)
+	for _, expected := range []struct {
+		ask string
+		qty int64
+	}{{ask: "1", qty: 8}, {ask: "2", qty: 2}} {
+		trade := <-ob.TradeEvents
+		suite.Equal(expected.ask, trade.AskOrderID)
+		suite.Equal(expected.qty, trade.Qty)
+	}
+	for len(ob.Events) > 0 {
+		suite.NotEqual(EventTypeSelfTradePrevented, (<-ob.Events).Type)
+	}
+	resting, ok := ob.findActiveOrder("3")
+	suite.True(ok)
+	suite.False(resting.IsCancelled())
+	suite.Equal(int64(2), resting.UnfilledQty())
+
+	// the order of the same customer gets a share of the next order, the self-trade prevention cancels both
+	incoming = createOrder("5", KindLimit, 0, 40, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	incoming.CustomerID = "wash"
+	incoming.STPMode = STPCancelBoth
+	matched, err = ob.Add(ctx, incoming)
+	suite.NoError(err)
+	suite.False(matched)
+	suite.Len(ob.TradeEvents, 0)
+	_, ok = ob.findActiveOrder("3")
+	suite.False(ok)
+	_, ok = ob.findActiveOrder("5")
+	suite.False(ok)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_MarketPolicy() {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		policy    MarketPolicy
+		side      Side
+		filled    int64
+		remainder MarketRemainder
+		price     string
+	}{
+		{name: "rest", policy: MarketPolicyRest, side: SideBuy, filled: 15, remainder: MarketRemainderNone, price: "0"},
+		{name: "cancel", policy: MarketPolicyCancel, side: SideBuy, filled: 15, remainder: MarketRemainderCancelled, price: "0"},
+		{name: "market-to-limit", policy: MarketPolicyLimit, side: SideBuy, filled: 15, remainder: MarketRemainderLimited, price: "20.05"},
+		{name: "collar", policy: MarketPolicyCollar, side: SideBuy, filled: 10, remainder: MarketRemainderLimited, price: "20.02"},
+		{name: "collar of the market price", policy: MarketPolicyCollar, side: SideSell, filled: 0, remainder: MarketRemainderLimited, price: "20.23"},
+	}
+
+	for _, tt := range tests {
+		ob := NewOrderBook(instrument, *apd.New(2025, -2), &NopRepository{}, WithInstrument(Instrument{
+			MarketPolicy: tt.policy,
+			CollarTicks:  2,
+		}))
+		for i, price := range []int64{2000, 2001, 2005} {
+			_, err := ob.Add(ctx, createOrder(fmt.Sprint(i), KindLimit, 0, 5, *apd.New(price, -2), apd.Decimal{}, SideSell))
+			suite.NoError(err, tt.name)
+		}
+
+		report, err := ob.Place(ctx, createOrder("market", KindMarket, 0, 20, apd.Decimal{}, apd.Decimal{}, tt.side))
+		suite.NoError(err, tt.name)
+		suite.Equal(tt.filled, report.Order.FilledQty, tt.name)
+		suite.Equal(tt.remainder, report.MarketRemainder, tt.name)
+		suite.Equal(tt.price, report.Order.Price.String(), tt.name)
+
+		resting, ok := ob.findActiveOrder("market")
+		suite.Equal(tt.remainder != MarketRemainderCancelled, ok, tt.name)
+		if tt.remainder == MarketRemainderLimited {
+			suite.Equal(KindLimit, resting.Kind, tt.name)
+		}
+	}
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Notional() {
+	ob := NewOrderBook(instrument, *apd.New(2025, -2), &NopRepository{}, WithInstrument(Instrument{LotSize: 2}))
+	ctx := context.Background()
+
+	for i, price := range []int64{2000, 2050} {
+		_, err := ob.Add(ctx, createOrder(fmt.Sprint(i), KindLimit, 0, 4, *apd.New(price, -2), apd.Decimal{}, SideSell))
+		suite.NoError(err)
+	}
+
+	// 4 at 20.00, the rest of 70 buys 3 at 20.50 which is rounded down to the lot size
+	notional := createOrder("notional", KindMarket, 0, 0, apd.Decimal{}, apd.Decimal{}, SideBuy)
+	notional.Notional = *apd.New(150, 0)
+	report, err := ob.Place(ctx, notional)
+	suite.NoError(err)
+	suite.Equal(int64(6), report.Order.Qty)
+	suite.Equal(int64(6), report.Order.FilledQty)
+	suite.Equal("121.00", report.Order.FilledNotional.String())
+	suite.True(report.Order.IsFilled())
+	suite.Require().Len(ob.TradeEvents, 2)
+	for _, total := range []string{"80.00", "41.00"} {
+		trade := <-ob.TradeEvents
+		suite.Equal(total, trade.Total.String())
+	}
+
+	// the notional doesn't buy a lot at the best price, the order is cancelled
+	notional = createOrder("small", KindMarket, 0, 0, apd.Decimal{}, apd.Decimal{}, SideBuy)
+	notional.Notional = *apd.New(30, 0)
+	report, err = ob.Place(ctx, notional)
+	suite.NoError(err)
+	suite.True(report.Order.IsCancelled())
+	suite.Equal(int64(0), report.Order.FilledQty)
+	_, ok := ob.findActiveOrder("small")
+	suite.False(ok)
+
+	// the notional replaces the quantity, all-or-nothing orders can't be sized by it
+	invalid := createOrder("qty", KindMarket, 0, 2, apd.Decimal{}, apd.Decimal{}, SideBuy)
+	invalid.Notional = *apd.New(100, 0)
+	_, err = ob.Place(ctx, invalid)
+	suite.ErrorIs(err, ErrInvalidNotional)
+
+	invalid = createOrder("aon", KindMarket, ConditionAON, 0, apd.Decimal{}, apd.Decimal{}, SideBuy)
+	invalid.Notional = *apd.New(100, 0)
+	_, err = ob.Place(ctx, invalid)
+	suite.ErrorIs(err, ErrInvalidNotional)
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Fees() {
+	fees := FeeSchedule{
+		Maker: *apd.New(-1, -4),
+		Taker: *apd.New(5, -4),
+		Tiers: []FeeTier{{Name: "vip", Customers: []string{"vip"}, Maker: *apd.New(-2, -4), Taker: *apd.New(3, -4)}},
+	}
+	books, err := (&BooksFactory{Mode: "config", OrderRepo: &NopRepository{}, Books: []BookConfig{
+		{Symbol: instrument, MarketPrice: *apd.New(2025, -2), Instrument: Instrument{Fees: fees}},
+	}}).Create()
+	suite.NoError(err)
+	ob := books[instrument]
+	ctx := context.Background()
+
+	vip := createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideSell)
+	vip.CustomerID = "vip"
+	_, err = ob.Add(ctx, vip)
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("2", KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	// the buyer takes the liquidity of the vip seller at the default taker rate, the seller gets the vip rebate
+	_, err = ob.Add(ctx, createOrder("3", KindMarket, 0, 10, apd.Decimal{}, apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.Require().Len(ob.TradeEvents, 1)
+	trade := <-ob.TradeEvents
+	suite.Equal(SideBuy, trade.Aggressor)
+	suite.Equal(0, trade.Total.Cmp(apd.New(200, 0)))
+	suite.Equal(0, trade.BuyerFee.Cmp(apd.New(1, -1)))
+	suite.Equal(0, trade.SellerFee.Cmp(apd.New(-4, -2)))
+
+	// the vip buyer makes the liquidity
+	buy := createOrder("4", KindLimit, 0, 10, *apd.New(1990, -2), apd.Decimal{}, SideBuy)
+	buy.CustomerID = "vip"
+	_, err = ob.Add(ctx, buy)
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("5", KindMarket, 0, 10, apd.Decimal{}, apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	suite.Require().Len(ob.TradeEvents, 1)
+	trade = <-ob.TradeEvents
+	suite.Equal(SideSell, trade.Aggressor)
+	suite.Equal(0, trade.BuyerFee.Cmp(apd.New(-398, -4)))
+	suite.Equal(0, trade.SellerFee.Cmp(apd.New(995, -4)))
+
+	// a rebate can't exceed the taker rate, nor the taker rate of any other tier, the scale has to be a power of ten
+	for _, invalid := range []FeeSchedule{
+		{Maker: *apd.New(-1, -3), Taker: *apd.New(5, -4)},
+		{Maker: *apd.New(-2, -4), Taker: *apd.New(5, -4), Tiers: []FeeTier{{Name: "vip", Maker: *apd.New(-1, -4), Taker: *apd.New(1, -4)}}},
+		{Maker: *apd.New(-1, -4), Taker: *apd.New(5, -4), Tiers: []FeeTier{{Name: "vip", Maker: *apd.New(-3, -4), Taker: *apd.New(5, -4)}, {Name: "retail", Taker: *apd.New(2, -4)}}},
+		{Taker: *apd.New(5, -4), Scale: *apd.New(5, -2)},
+	} {
+		_, err = (&BooksFactory{Mode: "config", OrderRepo: &NopRepository{}, Books: []BookConfig{
+			{Symbol: instrument, Instrument: Instrument{Fees: invalid}},
+		}}).Create()
+		suite.ErrorIs(err, ErrInvalidInstrument)
+	}
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Fees_Scale() {
+	fees := FeeSchedule{Maker: *apd.New(-1, -4), Taker: *apd.New(5, -4), Scale: *apd.New(10, -3)}
+	books, err := (&BooksFactory{Mode: "config", OrderRepo: &NopRepository{}, Books: []BookConfig{
+		{Symbol: instrument, MarketPrice: *apd.New(2025, -2), Instrument: Instrument{Fees: fees}},
+	}}).Create()
+	suite.NoError(err)
+	ob := books[instrument]
+	ctx := context.Background()
+
+	_, err = ob.Add(ctx, createOrder("1", KindLimit, 0, 7, *apd.New(2013, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("2", KindMarket, 0, 7, apd.Decimal{}, apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+
+	// 140.91 pays 0.070455 and gets a rebate of 0.014091, both are rounded to cents
+	suite.Require().Len(ob.TradeEvents, 1)
+	trade := <-ob.TradeEvents
+	suite.Equal("140.91", trade.Total.String())
+	suite.Equal("0.07", trade.BuyerFee.String())
+	suite.Equal("-0.01", trade.SellerFee.String())
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_Auction_Fees() {
+	fees := FeeSchedule{
+		Maker: *apd.New(-1, -4),
+		Taker: *apd.New(5, -4),
+		Tiers: []FeeTier{{Name: "vip", Customers: []string{"vip"}, Maker: *apd.New(-2, -4), Taker: *apd.New(3, -4)}},
+	}
+	books, err := (&BooksFactory{Mode: "config", OrderRepo: &NopRepository{}, Books: []BookConfig{
+		{Symbol: instrument, MarketPrice: *apd.New(2025, -2), Instrument: Instrument{Fees: fees}},
+	}}).Create()
+	suite.NoError(err)
+	ob := books[instrument]
+	ctx := context.Background()
+
+	suite.NoError(ob.SetPhase(ctx, PhasePreOpen))
+	buy := createOrder("1", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideBuy)
+	buy.CustomerID = "vip"
+	_, err = ob.Add(ctx, buy)
+	suite.NoError(err)
+	_, err = ob.Add(ctx, createOrder("2", KindLimit, 0, 10, *apd.New(2000, -2), apd.Decimal{}, SideSell))
+	suite.NoError(err)
+
+	// there is no aggressor in an auction, both sides pay their taker rate
+	suite.NoError(ob.SetPhase(ctx, PhaseContinuous))
+	suite.Require().Len(ob.TradeEvents, 1)
+	trade := <-ob.TradeEvents
+	suite.True(trade.Auction)
+	suite.Equal(SideNone, trade.Aggressor)
+	suite.Equal(0, trade.Total.Cmp(apd.New(200, 0)))
+	suite.Equal(0, trade.BuyerFee.Cmp(apd.New(6, -2)))
+	suite.Equal(0, trade.SellerFee.Cmp(apd.New(1, -1)))
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_FIFO_Decimal_Prices() {
+	ob := NewOrderBook(instrument, *apd.New(3, -1), &NopRepository{})
+	ctx := context.Background()
+
+	// 0.1 + 0.2 and 0.30 are the same price level as 0.3
+	var sum apd.Decimal
+	_, err := decimalContext.Add(&sum, apd.New(1, -1), apd.New(2, -1))
+	suite.NoError(err)
+	for i, price := range []apd.Decimal{*apd.New(30, -2), sum, *apd.New(3, -1), *apd.New(300, -3)} {
+		_, err := ob.Add(ctx, createOrder(fmt.Sprint(i), KindLimit, 0, 5, price, apd.Decimal{}, SideSell))
+		suite.NoError(err)
+	}
+
+	_, err = ob.Add(ctx, createOrder("buy", KindLimit, 0, 20, *apd.New(3, -1), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.Require().Len(ob.TradeEvents, 4)
+	for i := 0; i < 4; i++ {
+		trade := <-ob.TradeEvents
+		suite.Equal(fmt.Sprint(i), trade.AskOrderID)
+	}
+}
+
+func (suite *orderBookTestSuite) TestOrderBook_FIFO_Same_Timestamp() {
+	ob := suite.ob
+	ctx := context.Background()
+
+	// orders of the same price and timestamp are different keys of the books, sorted by their arrival
+	createdAt := time.Now()
+	for i := 0; i < 3; i++ {
+		order := createOrder(fmt.Sprint(i), KindLimit, 0, 5, *apd.New(2000, -2), apd.Decimal{}, SideSell)
+		order.CreatedAt = createdAt
+		_, err := ob.Add(ctx, order)
+		suite.NoError(err)
+	}
+	suite.Len(ob.GetAsks(), 3)
+
+	_, err := ob.Add(ctx, createOrder("buy", KindLimit, 0, 15, *apd.New(2000, -2), apd.Decimal{}, SideBuy))
+	suite.NoError(err)
+	suite.Require().Len(ob.TradeEvents, 3)
+	for i := 0; i < 3; i++ {
+		trade := <-ob.TradeEvents
+		suite.Equal(fmt.Sprint(i), trade.AskOrderID)
+	}
+	suite.Len(ob.GetAsks(), 0)
+}
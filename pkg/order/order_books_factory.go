@@ -1,27 +1,84 @@
 package order
 
-import "github.com/cockroachdb/apd"
+import (
+	"fmt"
+
+	"github.com/cockroachdb/apd"
+)
 
 type BooksFactory struct {
 	Mode      string
 	OrderRepo Repository
+	Books     []BookConfig // the order books in config mode
+}
+
+// BookConfig is the reference data an order book is created with in config mode
+type BookConfig struct {
+	Symbol         string
+	MarketPrice    apd.Decimal
+	ReferencePrice apd.Decimal       // the price band is set around it, the band follows the market price when it is zero
+	Instrument     Instrument        // the instrument is validated before the order book is created
+	Schedule       Schedule          // the order book trades continuously without schedule
+	Algorithm      MatchingAlgorithm // FIFO when it is nil
 }
 
-func (config *BooksFactory) Create() map[string]*OrderBook {
+func (config *BooksFactory) Create() (map[string]*OrderBook, error) {
 	switch config.Mode {
 	case "demo":
-		return newExampleOrderBooks(config.OrderRepo)
+		return newExampleOrderBooks(config.OrderRepo), nil
+	case "config":
+		return newConfigOrderBooks(config.Books, config.OrderRepo)
 	case "fetch":
 		panic("not yet implement")
 	}
 
-	return nil
+	return nil, nil
 }
 
 func newExampleOrderBooks(repo Repository) map[string]*OrderBook {
 	orderBooks := map[string]*OrderBook{
-		"TEST": NewOrderBook("TEST", *apd.New(2025, -2), repo),
+		"TEST": NewOrderBook("TEST", *apd.New(2025, -2), repo, WithInstrument(Instrument{
+			TickSize: *apd.New(1, -2),
+			LotSize:  1,
+			MaxQty:   1000000,
+		})),
 	}
 
 	return orderBooks
 }
+
+// newConfigOrderBooks creates an order book of every configured book
+func newConfigOrderBooks(books []BookConfig, repo Repository) (map[string]*OrderBook, error) {
+	orderBooks := make(map[string]*OrderBook, len(books))
+	for _, cfg := range books {
+		if cfg.Symbol == "" {
+			return nil, fmt.Errorf("instrument without symbol %w", ErrInvalidInstrument)
+		}
+		if _, ok := orderBooks[cfg.Symbol]; ok {
+			return nil, fmt.Errorf("instrument %s is defined twice %w", cfg.Symbol, ErrInvalidInstrument)
+		}
+		if cfg.MarketPrice.Sign() < 0 || cfg.ReferencePrice.Sign() < 0 {
+			return nil, fmt.Errorf("instrument %s market price %s reference price %s %w", cfg.Symbol, &cfg.MarketPrice, &cfg.ReferencePrice, ErrInvalidInstrument)
+		}
+		if err := cfg.Instrument.Validate(); err != nil {
+			return nil, fmt.Errorf("instrument %s %w", cfg.Symbol, err)
+		}
+		if !cfg.Schedule.IsZero() && !cfg.Schedule.IsValid() {
+			return nil, fmt.Errorf("instrument %s schedule phases don't start in their order %w", cfg.Symbol, ErrInvalidInstrument)
+		}
+
+		algorithm := cfg.Algorithm
+		if algorithm == nil {
+			algorithm = FIFO{}
+		}
+		book := NewOrderBook(cfg.Symbol, cfg.MarketPrice, repo,
+			WithInstrument(cfg.Instrument),
+			WithSchedule(cfg.Schedule),
+			WithMatchingAlgorithm(algorithm),
+		)
+		book.SetReferencePrice(cfg.ReferencePrice)
+		orderBooks[cfg.Symbol] = book
+	}
+
+	return orderBooks, nil
+}
@@ -0,0 +1,183 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// Bracket is an entry order with a take-profit and a stop-loss order which close the position opened by the entry.
+// The children are inactive until the entry fills, they are sized to the filled quantity of the entry
+// and cancel each other as a one-cancels-other group.
+type Bracket struct {
+	Entry      Order
+	TakeProfit *Order // a limit order on the other side of the entry, optional
+	StopLoss   *Order // a stop order on the other side of the entry, optional
+}
+
+// bracket keeps the children of an entry order until the entry is finished.
+type bracket struct {
+	children []Order // the stop-loss goes first, so a take-profit filled right away cancels it
+	active   bool    // the children were placed in the books
+	filled   int64   // the filled quantity of the entry which isn't covered by the children yet
+}
+
+// bracketFill is a fill of a bracket entry order.
+type bracketFill struct {
+	entryID string
+	qty     int64
+	done    bool // the entry is finished, it won't be filled anymore
+}
+
+// PlaceBracket places the entry order of a bracket the same way as Place, the children are placed once it fills.
+func (o *OrderBook) PlaceBracket(ctx context.Context, b Bracket) (ExecutionReport, error) {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+
+	if err := o.checkHalted(); err != nil {
+		return ExecutionReport{}, err
+	}
+	if err := o.checkPhase(&b.Entry); err != nil {
+		return ExecutionReport{}, err
+	}
+	entry := b.Entry
+	if entry.Qty <= MinQty { // check the qty
+		return ExecutionReport{}, ErrInvalidQty
+	}
+	if entry.IsOCO() || (b.TakeProfit == nil && b.StopLoss == nil) {
+		return ExecutionReport{}, ErrInvalidBracket
+	}
+	if _, ok := o.brackets[entry.ID]; ok {
+		return ExecutionReport{}, ErrInvalidBracket
+	}
+
+	pending := &bracket{children: make([]Order, 0, 2)}
+	if b.StopLoss != nil {
+		if !b.StopLoss.Params.Is(ConditionStop) {
+			return ExecutionReport{}, ErrInvalidBracket
+		}
+		pending.children = append(pending.children, *b.StopLoss)
+	}
+	if b.TakeProfit != nil {
+		if b.TakeProfit.Kind != KindLimit || b.TakeProfit.Params.Is(ConditionStop) {
+			return ExecutionReport{}, ErrInvalidBracket
+		}
+		pending.children = append(pending.children, *b.TakeProfit)
+	}
+	for i := range pending.children {
+		child := &pending.children[i]
+		if child.Side == entry.Side {
+			return ExecutionReport{}, ErrInvalidBracket
+		}
+		child.TickerSymbol = entry.TickerSymbol
+		child.CustomerID = entry.CustomerID
+		child.OCOGroupID = entry.ID
+		child.Qty = entry.Qty
+
+		check := *child // prices derived from the books are set once the child is placed
+		if err := o.prepare(&check); err != nil {
+			return ExecutionReport{}, err
+		}
+	}
+
+	o.brackets[entry.ID] = pending
+	report, err := o.place(ctx, entry)
+	if err != nil {
+		delete(o.brackets, entry.ID)
+	}
+	return report, err
+}
+
+// activateBrackets places the children of the filled bracket entries sized to the filled quantity,
+// further fills of an entry increase the quantity of its children which are still in the books.
+// The fills are accumulated until the children pass the checks of a new order, e.g. the min qty of the instrument,
+// children which still can't be placed once the entry is finished are cancelled and the cancel is published.
+// Once a leg is filled or triggered the rest of the entry is cancelled with its sibling,
+// so later fills never open an unprotected position.
+func (o *OrderBook) activateBrackets(ctx context.Context, fills []bracketFill) {
+	for _, fill := range fills {
+		pending, ok := o.brackets[fill.entryID]
+		if !ok {
+			continue
+		}
+		if fill.done {
+			delete(o.brackets, fill.entryID)
+		}
+		pending.filled += fill.qty
+
+		if pending.active {
+			o.resizeChildren(ctx, pending)
+			continue
+		}
+		if fill.done || o.checkChildren(pending) == nil { // otherwise wait for further fills of the entry
+			o.placeChildren(ctx, pending)
+		}
+	}
+}
+
+// placeChildren places the children sized to the filled quantity of the entry,
+// a child which can't be placed is cancelled and the cancel is published.
+func (o *OrderBook) placeChildren(ctx context.Context, pending *bracket) {
+	for _, child := range pending.children {
+		child.Qty = pending.filled
+		if _, err := o.placeChecked(ctx, child); err != nil {
+			o.rejectChild(ctx, child, err)
+		}
+	}
+	pending.active = true
+	pending.filled = 0
+}
+
+// checkChildren checks the children sized to the filled quantity the same way as they are checked once placed.
+func (o *OrderBook) checkChildren(pending *bracket) error {
+	for _, child := range pending.children {
+		child.Qty = pending.filled
+		if err := o.checkPhase(&child); err != nil {
+			return err
+		}
+		if child.Qty <= MinQty {
+			return ErrInvalidQty
+		}
+		if err := o.prepare(&child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resizeChildren adds the filled quantity of the entry to its children which are still in the books.
+func (o *OrderBook) resizeChildren(ctx context.Context, pending *bracket) {
+	for _, child := range pending.children {
+		order, ok := o.findActiveOrder(child.ID)
+		if !ok || order.IsCancelled() {
+			continue // the child is finished already
+		}
+		order.Qty += pending.filled
+		if err := o.updateActiveOrder(ctx, order); err != nil {
+			log.Println(err)
+		}
+	}
+	pending.filled = 0
+}
+
+// finishBracket forgets the bracket of the finished entry, children which are active already stay in the books
+// and children still waiting for enough fills of the entry are placed or cancelled.
+func (o *OrderBook) finishBracket(ctx context.Context, entryID string) {
+	pending, ok := o.brackets[entryID]
+	if !ok {
+		return
+	}
+	delete(o.brackets, entryID)
+	if !pending.active && pending.filled > 0 {
+		o.placeChildren(ctx, pending)
+	}
+}
+
+// rejectChild stores the bracket child which couldn't be placed as cancelled and tells why
+func (o *OrderBook) rejectChild(ctx context.Context, child Order, err error) {
+	child.Cancel()
+	if err := o.saveOrder(ctx, &child); err != nil {
+		log.Println(err)
+	}
+	o.publishEvent(EventTypeCancelled, &child, fmt.Sprintf("bracket order of entry %s not placed %v", child.OCOGroupID, err))
+}
@@ -4,9 +4,38 @@ import "errors"
 
 var (
 	ErrInvalidQty          = errors.New("invalid quantity provided")
+	ErrInvalidNotional     = errors.New("notional has to be positive without quantity for a market or limit order which is matched immediately")
+	ErrInvalidDisplayQty   = errors.New("display quantity has to be positive and not bigger than the quantity of a limit order")
 	ErrInvalidTickerSymbol = errors.New("invalid ticker symbol")
+	ErrInvalidTickSize     = errors.New("price has to be a multiple of the tick size")
+	ErrInvalidLotSize      = errors.New("quantity has to be a multiple of the lot size")
+	ErrQtyBelowMin         = errors.New("quantity is below the minimum quantity")
+	ErrQtyAboveMax         = errors.New("quantity is above the maximum quantity")
+	ErrNotionalBelowMin    = errors.New("notional is below the minimum notional")
+	ErrNotionalAboveMax    = errors.New("notional is above the maximum notional")
 	ErrInvalidMarketPrice  = errors.New("price has to be zero for market orders")
 	ErrInvalidLimitPrice   = errors.New("price has to be set for limit orders")
 	ErrInvalidStopPrice    = errors.New("stop price has to be set for a stop order")
+	ErrInvalidTrail        = errors.New("either trail amount or trail percent has to be set for a trailing stop order")
+	ErrInvalidExpireAt     = errors.New("expire time has to be set in the future for a good-till-date order")
+	ErrInvalidPostOnly     = errors.New("post-only has to be set for a limit order")
+	ErrPostOnlyWouldTake   = errors.New("post-only order would take liquidity")
+	ErrPriceOutsideBand    = errors.New("price is outside the price band")
+	ErrTradingHalted       = errors.New("trading is halted")
+	ErrNotHalted           = errors.New("trading is not halted")
+	ErrMarketClosed        = errors.New("order book is closed")
+	ErrNotContinuous       = errors.New("immediate-or-cancel and fill-or-kill orders are only accepted during continuous trading")
+	ErrInvalidPhase        = errors.New("invalid trading phase")
+	ErrInvalidPeg          = errors.New("peg has to be set for a limit order which is not a stop order")
+	ErrPegPriceNotFound    = errors.New("there is no price in the books to peg the order to")
+	ErrInvalidSTPMode      = errors.New("self-trade prevention mode is not supported")
+	ErrInvalidBracket      = errors.New("take-profit has to be a limit and stop-loss a stop order on the other side of the entry")
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrDuplicateClientID   = errors.New("client order id is already used by another order of the customer")
+	ErrInvalidCancelFilter = errors.New("cancel filter has to select orders by customer, ticker symbol or side")
+	ErrInvalidSession      = errors.New("session has to have a customer and a non-negative heartbeat timeout")
+	ErrSessionNotFound     = errors.New("session not found or already closed")
+	ErrOrderFinished       = errors.New("order is already filled, cancelled or expired")
+	ErrInvalidInstrument   = errors.New("invalid instrument reference data")
 	ErrInternal            = errors.New("internal error")
 )
@@ -0,0 +1,76 @@
+package order
+
+import (
+	"time"
+
+	"github.com/cockroachdb/apd"
+	"github.com/google/uuid"
+)
+
+// EventType is the kind of Event an order book emits
+type EventType int8
+
+const (
+	EventTypeExpired            EventType = iota + 1 // an order was expired by its time in force
+	EventTypeCancelled                               // an order was cancelled on behalf of another order, e.g. its OCO sibling
+	EventTypeSelfTradePrevented                      // an order was cancelled or decremented instead of trading with the same customer
+	EventTypePriceBandBreached                       // an order stopped matching at a price outside the price band
+	EventTypeHalted                                  // trading in the order book was halted
+	EventTypeResumed                                 // trading in the order book was resumed or is re-opening
+	EventTypePhaseChanged                            // the trading phase of the order book changed
+	EventTypeIndicativeAuction                       // the indicative price and volume of the call auction changed
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventTypeExpired:
+		return "expired"
+	case EventTypeCancelled:
+		return "cancelled"
+	case EventTypeSelfTradePrevented:
+		return "self-trade prevented"
+	case EventTypePriceBandBreached:
+		return "price band breached"
+	case EventTypeHalted:
+		return "halted"
+	case EventTypeResumed:
+		return "resumed"
+	case EventTypePhaseChanged:
+		return "phase changed"
+	case EventTypeIndicativeAuction:
+		return "indicative auction"
+	default:
+		return "invalid"
+	}
+}
+
+// Event is an order book notification other than a trade, e.g. an order has expired.
+type Event struct {
+	ID           string
+	Type         EventType
+	TickerSymbol string
+	OrderID      string
+	CustomerID   string
+	Reason       string
+	Timestamp    time.Time
+
+	Price apd.Decimal // the indicative auction price
+	Qty   int64       // the indicative auction volume
+}
+
+// publishEvent sends an event about the order to the Events channel.
+func (o *OrderBook) publishEvent(eventType EventType, order *Order, reason string) {
+	event := Event{
+		ID:           uuid.New().String(),
+		Type:         eventType,
+		TickerSymbol: o.TickerSymbol,
+		Reason:       reason,
+		Timestamp:    time.Now(),
+	}
+	if order != nil {
+		event.OrderID = order.ID
+		event.CustomerID = order.CustomerID
+	}
+
+	o.Events <- event
+}
@@ -0,0 +1,162 @@
+package order
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// endOfDay returns the end of the trading day t belongs to, used by good-for-day orders.
+func endOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+}
+
+type expiryEntry struct {
+	orderID  string
+	expireAt time.Time
+	index    int // the index of the entry in the heap
+}
+
+// expiryQueue is a min heap of orders sorted by their expire time.
+type expiryQueue []*expiryEntry
+
+func (q expiryQueue) Len() int           { return len(q) }
+func (q expiryQueue) Less(i, j int) bool { return q[i].expireAt.Before(q[j].expireAt) }
+func (q expiryQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *expiryQueue) Push(x any) {
+	entry := x.(*expiryEntry)
+	entry.index = len(*q)
+	*q = append(*q, entry)
+}
+
+func (q *expiryQueue) Pop() any {
+	old := *q
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return entry
+}
+
+// expiryScheduler keeps a single timer armed for the earliest expire time of an order book.
+// An order has a single entry, which is removed once the order leaves the books.
+type expiryScheduler struct {
+	mutex   sync.Mutex
+	queue   expiryQueue
+	entries map[string]*expiryEntry // the entries by order ID
+	timer   *time.Timer
+	fire    func(now time.Time)
+}
+
+func newExpiryScheduler(fire func(now time.Time)) *expiryScheduler {
+	return &expiryScheduler{
+		queue:   make(expiryQueue, 0),
+		entries: make(map[string]*expiryEntry),
+		fire:    fire,
+	}
+}
+
+// schedule the order to be expired at the provided time, an order scheduled before is moved to the new time.
+func (s *expiryScheduler) schedule(orderID string, expireAt time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if entry, ok := s.entries[orderID]; ok {
+		if entry.expireAt.Equal(expireAt) {
+			return // already scheduled, e.g. a requeued or triggered order
+		}
+		entry.expireAt = expireAt
+		heap.Fix(&s.queue, entry.index)
+	} else {
+		entry = &expiryEntry{orderID: orderID, expireAt: expireAt}
+		heap.Push(&s.queue, entry)
+		s.entries[orderID] = entry
+	}
+	s.arm()
+}
+
+// cancel removes the order which left the books from the schedule.
+func (s *expiryScheduler) cancel(orderID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	entry, ok := s.entries[orderID]
+	if !ok {
+		return
+	}
+	heap.Remove(&s.queue, entry.index)
+	delete(s.entries, orderID)
+	s.arm()
+}
+
+// scheduled returns the number of scheduled orders.
+func (s *expiryScheduler) scheduled() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.queue.Len()
+}
+
+// due pops all orders which have to be expired at now.
+func (s *expiryScheduler) due(now time.Time) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	ids := make([]string, 0)
+	for s.queue.Len() > 0 && !s.queue[0].expireAt.After(now) {
+		entry := heap.Pop(&s.queue).(*expiryEntry)
+		delete(s.entries, entry.orderID)
+		ids = append(ids, entry.orderID)
+	}
+	s.arm()
+	return ids
+}
+
+// arm the timer for the earliest entry, s.mutex has to be held.
+func (s *expiryScheduler) arm() {
+	if s.queue.Len() == 0 {
+		if s.timer != nil {
+			s.timer.Stop()
+		}
+		return
+	}
+
+	wait := time.Until(s.queue[0].expireAt)
+	if s.timer == nil {
+		s.timer = time.AfterFunc(wait, func() { s.fire(time.Now()) })
+		return
+	}
+	s.timer.Stop()
+	s.timer.Reset(wait)
+}
+
+// expireOrders removes every resting and stop order whose time in force has elapsed at now.
+func (o *OrderBook) expireOrders(ctx context.Context, now time.Time) {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+
+	for _, id := range o.expiry.due(now) {
+		o.expireOrder(ctx, id)
+	}
+	o.repegOrders(ctx)
+}
+
+// expireOrder removes the order from the books as expired, o.matchMutex has to be held.
+func (o *OrderBook) expireOrder(ctx context.Context, id string) {
+	order, ok := o.findActiveOrder(id)
+	if !ok || order.IsCancelled() {
+		return // order is already finished
+	}
+
+	order.Expire()
+	o.finishBracket(ctx, id)
+	o.orderMutex.Lock()
+	o.activeOrders[id] = order
+	o.orderMutex.Unlock()
+	o.removeFromBooks(ctx, id) // stores the expired order
+
+	o.publishEvent(EventTypeExpired, &order, order.Params.String())
+}
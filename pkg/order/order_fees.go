@@ -0,0 +1,105 @@
+package order
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/cockroachdb/apd"
+)
+
+// FeeSchedule is the fees of the trades of an instrument in rates of the trade notional, e.g. 0.001 means 0.1%.
+// A negative maker rate is a rebate paid to the customer which provided the liquidity.
+type FeeSchedule struct {
+	Maker apd.Decimal // the rate of the resting order of a trade
+	Taker apd.Decimal // the rate of the incoming order of a trade, both sides of an auction trade take liquidity
+	Tiers []FeeTier   // the customers of a tier trade at its rates instead
+	Scale apd.Decimal // the fees are rounded half up to a multiple of it, e.g. 0.01, they aren't rounded when it is zero
+}
+
+// FeeTier is the rates of a group of customers, e.g. by their monthly volume
+type FeeTier struct {
+	Name      string
+	Customers []string
+	Maker     apd.Decimal
+	Taker     apd.Decimal
+}
+
+// Validate checks the rates never pay out more than they charge, a maker rebate can't exceed the lowest taker rate of
+// any tier as the customers of different tiers trade with each other. The scale has to be a power of ten.
+func (s *FeeSchedule) Validate() error {
+	var scale apd.Decimal
+	scale.Reduce(&s.Scale)
+	if scale.Sign() < 0 || (scale.Sign() > 0 && scale.Coeff.Cmp(big.NewInt(1)) != 0) {
+		return fmt.Errorf("fee scale %s %w", &s.Scale, ErrInvalidInstrument)
+	}
+
+	lowestTaker := &s.Taker
+	for i := range s.Tiers {
+		if s.Tiers[i].Taker.Cmp(lowestTaker) < 0 {
+			lowestTaker = &s.Tiers[i].Taker
+		}
+	}
+	if err := validateRates("default", &s.Maker, &s.Taker, lowestTaker); err != nil {
+		return err
+	}
+	for i := range s.Tiers {
+		if err := validateRates(s.Tiers[i].Name, &s.Tiers[i].Maker, &s.Tiers[i].Taker, lowestTaker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateRates(tier string, maker, taker, lowestTaker *apd.Decimal) error {
+	var spread apd.Decimal
+	if _, err := decimalContext.Add(&spread, maker, lowestTaker); err != nil {
+		return err
+	}
+	if taker.Sign() < 0 || spread.Sign() < 0 {
+		return fmt.Errorf("fee tier %s maker %s taker %s lowest taker %s %w", tier, maker, taker, lowestTaker, ErrInvalidInstrument)
+	}
+	return nil
+}
+
+// rate returns the maker or the taker rate of the customer, the rates of the first tier of the customer
+func (s *FeeSchedule) rate(customerID string, maker bool) *apd.Decimal {
+	for i := range s.Tiers {
+		if !slices.Contains(s.Tiers[i].Customers, customerID) {
+			continue
+		}
+		if maker {
+			return &s.Tiers[i].Maker
+		}
+		return &s.Tiers[i].Taker
+	}
+	if maker {
+		return &s.Maker
+	}
+	return &s.Taker
+}
+
+// fees returns the fees of the buyer and the seller of a trade of the total notional, negative for a rebate.
+// The aggressor side takes liquidity, there is no aggressor in an auction trade.
+func (s *FeeSchedule) fees(buyer, seller string, aggressor Side, total *apd.Decimal) (buyerFee, sellerFee apd.Decimal, err error) {
+	if buyerFee, err = s.fee(total, s.rate(buyer, aggressor == SideSell)); err != nil {
+		return buyerFee, sellerFee, err
+	}
+	sellerFee, err = s.fee(total, s.rate(seller, aggressor == SideBuy))
+	return buyerFee, sellerFee, err
+}
+
+// fee returns the fee of the total notional at the rate rounded to the scale
+func (s *FeeSchedule) fee(total, rate *apd.Decimal) (apd.Decimal, error) {
+	var fee apd.Decimal
+	if _, err := decimalContext.Mul(&fee, total, rate); err != nil {
+		return fee, err
+	}
+	if s.Scale.Sign() == 0 {
+		return fee, nil
+	}
+	var scale apd.Decimal
+	scale.Reduce(&s.Scale) // 0.010 rounds to cents as well
+	_, err := decimalContext.Quantize(&fee, &fee, scale.Exponent)
+	return fee, err
+}
@@ -0,0 +1,47 @@
+package order
+
+import "context"
+
+// finishedOrdersSize is the number of the latest finished orders an order book remembers.
+const finishedOrdersSize = 10000
+
+// finishedOrders remembers the latest finished orders, so a cancel of a filled, cancelled or expired order
+// is told apart from an unknown order without a persistent repository. The oldest order is forgotten first.
+type finishedOrders struct {
+	orders map[string]Order
+	ids    []string // a ring of the remembered IDs in the order they were finished
+	next   int      // the index of the ring the next finished order is written to
+}
+
+func newFinishedOrders(size int) *finishedOrders {
+	return &finishedOrders{
+		orders: make(map[string]Order, size),
+		ids:    make([]string, size),
+	}
+}
+
+// add remembers the finished order, the latest version of an order which is remembered already replaces it.
+func (f *finishedOrders) add(order Order) {
+	if _, ok := f.orders[order.ID]; !ok {
+		delete(f.orders, f.ids[f.next])
+		f.ids[f.next] = order.ID
+		f.next = (f.next + 1) % len(f.ids)
+	}
+	f.orders[order.ID] = order
+}
+
+func (f *finishedOrders) find(id string) (Order, bool) {
+	order, ok := f.orders[id]
+	return order, ok
+}
+
+// saveOrder stores the order in the repository and remembers it once it is finished, o.matchMutex has to be held.
+func (o *OrderBook) saveOrder(ctx context.Context, order *Order) error {
+	if err := o.orderRepo.SaveOrder(ctx, order); err != nil {
+		return err
+	}
+	if order.IsFilled() || order.IsCancelled() || order.IsExpired() {
+		o.finished.add(*order)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// tradingHalt is the halt of an order book, new orders are rejected and nothing is matched until it resumes.
+// Orders are collected without matching while the order book is re-opening.
+type tradingHalt struct {
+	reason   string
+	resumeAt time.Time   // the re-opening time of a resumed order book, zero while it is halted
+	timer    *time.Timer // resumes continuous trading at resumeAt
+}
+
+// TradingStatus describes whether the order book is halted
+type TradingStatus struct {
+	Halted   bool
+	Reason   string
+	ResumeAt time.Time // continuous trading resumes at this time, zero until the order book is resumed
+}
+
+// Halt stops trading in the order book. New orders and amends are rejected and nothing is matched while the order
+// book is halted, cancels are still accepted. Halting a re-opening order book cancels its re-opening.
+func (o *OrderBook) Halt(ctx context.Context, reason string) {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+
+	if o.halt != nil && o.halt.timer != nil {
+		o.halt.timer.Stop()
+	}
+	o.halt = &tradingHalt{reason: reason}
+	o.publishEvent(EventTypeHalted, nil, reason)
+}
+
+// Resume restarts continuous trading in the halted order book, straight away or after the re-opening period
+// in which orders are collected without matching the same way as in the pre-open, they are uncrossed once it ends.
+func (o *OrderBook) Resume(ctx context.Context, reopenAfter time.Duration) (TradingStatus, error) {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+
+	halt := o.halt
+	if halt == nil {
+		return o.tradingStatus(), ErrNotHalted
+	}
+	if reopenAfter <= 0 {
+		o.resume(ctx)
+		return o.tradingStatus(), nil
+	}
+
+	if halt.timer != nil {
+		halt.timer.Stop()
+	}
+	halt.resumeAt = time.Now().Add(reopenAfter)
+	halt.timer = time.AfterFunc(reopenAfter, func() {
+		o.matchMutex.Lock()
+		defer o.matchMutex.Unlock()
+		if o.halt == halt { // not halted again in the meantime
+			o.resume(context.Background())
+		}
+	})
+	o.publishEvent(EventTypeResumed, nil, fmt.Sprintf("re-opening at %s", halt.resumeAt.UTC().Format(time.RFC3339)))
+	return o.tradingStatus(), nil
+}
+
+// TradingStatus returns whether the order book is halted
+func (o *OrderBook) TradingStatus() TradingStatus {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+	return o.tradingStatus()
+}
+
+// tradingStatus returns whether the order book is halted, o.matchMutex has to be held.
+func (o *OrderBook) tradingStatus() TradingStatus {
+	if o.halt == nil {
+		return TradingStatus{}
+	}
+	return TradingStatus{
+		Halted:   true,
+		Reason:   o.halt.reason,
+		ResumeAt: o.halt.resumeAt,
+	}
+}
+
+// checkHalted rejects new orders while the order book is halted and not re-opening, o.matchMutex has to be held.
+func (o *OrderBook) checkHalted() error {
+	if o.halt == nil || o.halt.reopening() {
+		return nil
+	}
+	return fmt.Errorf("%s %w", o.halt.reason, ErrTradingHalted)
+}
+
+// reopening returns true when the halted order book collects orders until it resumes.
+func (h *tradingHalt) reopening() bool {
+	return h != nil && !h.resumeAt.IsZero()
+}
+
+// resume restarts trading in the current phase, a continuous order book matches the crossed books, the stop orders
+// crossed by the market price and the pegged orders which moved during the halt now. o.matchMutex has to be held.
+func (o *OrderBook) resume(ctx context.Context) {
+	o.halt = nil
+	o.publishEvent(EventTypeResumed, nil, o.phase.String())
+
+	switch o.phase {
+	case PhaseContinuous:
+		o.uncross(ctx)
+		o.open(ctx)
+	case PhaseClosed:
+		o.uncross(ctx)
+		o.expireDayOrders(ctx)
+	}
+}
@@ -0,0 +1,164 @@
+package order
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/apd"
+)
+
+// Instrument is the reference data of the ticker symbol of an order book, zero values don't limit orders
+type Instrument struct {
+	TickSize    apd.Decimal // minimum price increment, above the last step of the tick ladder
+	TickLadder  []TickLevel // price dependent tick sizes by ascending bound
+	LotSize     int64       // the quantity has to be a multiple of the lot size
+	MinQty      int64       // minimum quantity of an order
+	MaxQty      int64       // maximum quantity of an order
+	MinNotional apd.Decimal // minimum price times quantity of a limit order
+	MaxNotional apd.Decimal // maximum price times quantity of a limit order
+
+	PriceBandPercent apd.Decimal // orders trade within this percent around the reference price, e.g. 5 means 5%
+	PriceBandPolicy  BandPolicy  // how the rest of a market order stopped at the price band is handled
+
+	MarketPolicy MarketPolicy // how the rest of a market order which found nothing more to match is handled
+	CollarTicks  int64        // the ticks from the best price at arrival market orders match within by MarketPolicyCollar
+
+	Fees FeeSchedule // the maker and taker fees of the trades
+}
+
+// TickLevel is a step of a tick ladder, prices below the bound use its tick size
+type TickLevel struct {
+	Below    apd.Decimal
+	TickSize apd.Decimal
+}
+
+// tickSizeAt returns the tick size of the price
+func (i *Instrument) tickSizeAt(price *apd.Decimal) *apd.Decimal {
+	for k := range i.TickLadder {
+		if price.Cmp(&i.TickLadder[k].Below) < 0 {
+			return &i.TickLadder[k].TickSize
+		}
+	}
+	return &i.TickSize
+}
+
+// tickSizeBelow returns the tick size of the prices right below the price, it differs from the tick size
+// of the price at the bound of a step
+func (i *Instrument) tickSizeBelow(price *apd.Decimal) *apd.Decimal {
+	for k := range i.TickLadder {
+		if price.Cmp(&i.TickLadder[k].Below) <= 0 {
+			return &i.TickLadder[k].TickSize
+		}
+	}
+	return &i.TickSize
+}
+
+// Validate checks the reference data is consistent, the tick ladder bounds have to ascend with positive tick sizes
+func (i *Instrument) Validate() error {
+	if i.LotSize < 0 || i.MinQty < 0 || i.MaxQty < 0 || (i.MaxQty > 0 && i.MinQty > i.MaxQty) {
+		return fmt.Errorf("lot size %d min qty %d max qty %d %w", i.LotSize, i.MinQty, i.MaxQty, ErrInvalidInstrument)
+	}
+	if i.TickSize.Sign() < 0 || i.MinNotional.Sign() < 0 || i.MaxNotional.Sign() < 0 || i.PriceBandPercent.Sign() < 0 {
+		return fmt.Errorf("tick size %s min notional %s max notional %s price band percent %s %w",
+			&i.TickSize, &i.MinNotional, &i.MaxNotional, &i.PriceBandPercent, ErrInvalidInstrument)
+	}
+	if i.CollarTicks < 0 || (i.MarketPolicy == MarketPolicyCollar && i.CollarTicks == 0) {
+		return fmt.Errorf("collar ticks %d %w", i.CollarTicks, ErrInvalidInstrument)
+	}
+	for k, level := range i.TickLadder {
+		if level.Below.Sign() <= 0 || level.TickSize.Sign() <= 0 {
+			return fmt.Errorf("tick ladder below %s tick size %s %w", &level.Below, &level.TickSize, ErrInvalidInstrument)
+		}
+		if k > 0 && i.TickLadder[k-1].Below.Cmp(&level.Below) >= 0 {
+			return fmt.Errorf("tick ladder bound %s doesn't ascend %w", &level.Below, ErrInvalidInstrument)
+		}
+	}
+	return i.Fees.Validate()
+}
+
+// roundToTick rounds the price to a multiple of the tick size of its step, up or down
+func (i *Instrument) roundToTick(price *apd.Decimal, up bool) (apd.Decimal, error) {
+	return roundToTick(price, i.tickSizeAt(price), up)
+}
+
+// validate checks the price and the quantity of the order against the instrument
+func (i *Instrument) validate(order *Order) error {
+	if order.IsNotional() { // the quantity follows from the notional, it is rounded to the lot size while matched
+		if err := i.validateTick(&order.Price); err != nil {
+			return err
+		}
+		return i.checkNotional(&order.Notional)
+	}
+	if err := i.validateQty(order.Qty); err != nil {
+		return err
+	}
+	if order.DisplayQty > 0 && i.LotSize > 1 && order.DisplayQty%i.LotSize != 0 {
+		return fmt.Errorf("display qty %d lot size %d %w", order.DisplayQty, i.LotSize, ErrInvalidLotSize)
+	}
+	if !order.Params.Is(ConditionTrailingStop) { // the trailing stop price follows the market price
+		if err := i.validateTick(&order.StopPrice); err != nil {
+			return err
+		}
+	}
+	if order.Kind != KindLimit { // the market order price is unknown until it is matched
+		return nil
+	}
+	if err := i.validateTick(&order.Price); err != nil {
+		return err
+	}
+	return i.validateNotional(&order.Price, order.Qty)
+}
+
+// validateQty checks the quantity is a multiple of the lot size within the quantity limits
+func (i *Instrument) validateQty(qty int64) error {
+	if i.LotSize > 1 && qty%i.LotSize != 0 {
+		return fmt.Errorf("qty %d lot size %d %w", qty, i.LotSize, ErrInvalidLotSize)
+	}
+	if i.MinQty > 0 && qty < i.MinQty {
+		return fmt.Errorf("qty %d min qty %d %w", qty, i.MinQty, ErrQtyBelowMin)
+	}
+	if i.MaxQty > 0 && qty > i.MaxQty {
+		return fmt.Errorf("qty %d max qty %d %w", qty, i.MaxQty, ErrQtyAboveMax)
+	}
+	return nil
+}
+
+// validateTick checks the price is a multiple of the tick size of its step, a zero price is not set
+func (i *Instrument) validateTick(price *apd.Decimal) error {
+	tickSize := i.tickSizeAt(price)
+	if price.IsZero() || tickSize.Sign() <= 0 {
+		return nil
+	}
+
+	var ticks apd.Decimal
+	if _, err := decimalContext.Rem(&ticks, price, tickSize); err != nil {
+		return err
+	}
+	if !ticks.IsZero() {
+		return fmt.Errorf("price %s tick size %s %w", price, tickSize, ErrInvalidTickSize)
+	}
+	return nil
+}
+
+// validateNotional checks the price times the quantity is within the notional limits
+func (i *Instrument) validateNotional(price *apd.Decimal, qty int64) error {
+	if i.MinNotional.IsZero() && i.MaxNotional.IsZero() {
+		return nil
+	}
+
+	var notional apd.Decimal
+	if _, err := decimalContext.Mul(&notional, price, apd.New(qty, 0)); err != nil {
+		return err
+	}
+	return i.checkNotional(&notional)
+}
+
+// checkNotional checks the notional is within the notional limits
+func (i *Instrument) checkNotional(notional *apd.Decimal) error {
+	if !i.MinNotional.IsZero() && notional.Cmp(&i.MinNotional) < 0 {
+		return fmt.Errorf("notional %s min notional %s %w", notional, &i.MinNotional, ErrNotionalBelowMin)
+	}
+	if !i.MaxNotional.IsZero() && notional.Cmp(&i.MaxNotional) > 0 {
+		return fmt.Errorf("notional %s max notional %s %w", notional, &i.MaxNotional, ErrNotionalAboveMax)
+	}
+	return nil
+}
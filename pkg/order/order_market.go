@@ -0,0 +1,143 @@
+package order
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/apd"
+)
+
+// MarketPolicy is how the rest of a market order which found nothing more to match is handled
+type MarketPolicy int8
+
+const (
+	MarketPolicyRest   MarketPolicy = iota // rest in the books ahead of the limit orders until it is matched
+	MarketPolicyCancel                     // cancel the rest of the market order
+	MarketPolicyLimit                      // market-to-limit, rest as a limit order at the last trade price
+	MarketPolicyCollar                     // match within a collar of ticks from the best price at arrival, rest at the collar
+)
+
+func (p MarketPolicy) String() string {
+	switch p {
+	case MarketPolicyRest:
+		return "rest"
+	case MarketPolicyCancel:
+		return "cancel"
+	case MarketPolicyLimit:
+		return "limit"
+	case MarketPolicyCollar:
+		return "collar"
+	default:
+		return "invalid"
+	}
+}
+
+// ParseMarketPolicy parses the name of a market policy, an empty name is MarketPolicyRest
+func ParseMarketPolicy(name string) (MarketPolicy, error) {
+	switch name {
+	case "", MarketPolicyRest.String():
+		return MarketPolicyRest, nil
+	case MarketPolicyCancel.String():
+		return MarketPolicyCancel, nil
+	case MarketPolicyLimit.String():
+		return MarketPolicyLimit, nil
+	case MarketPolicyCollar.String():
+		return MarketPolicyCollar, nil
+	default:
+		return MarketPolicyRest, fmt.Errorf("market policy %s %w", name, ErrInvalidInstrument)
+	}
+}
+
+// MarketRemainder is what happened to the rest of a market order
+type MarketRemainder int8
+
+const (
+	MarketRemainderNone      MarketRemainder = iota // the market order was filled or rests as a market order
+	MarketRemainderCancelled                        // the rest of the market order was cancelled
+	MarketRemainderLimited                          // the rest of the market order rests as a limit order at its price
+)
+
+func (r MarketRemainder) String() string {
+	switch r {
+	case MarketRemainderNone:
+		return "none"
+	case MarketRemainderCancelled:
+		return "cancelled"
+	case MarketRemainderLimited:
+		return "limited"
+	default:
+		return "invalid"
+	}
+}
+
+// marketCollar returns the price a market order matches up to by the collar policy, the collar ticks away from the
+// best opposite price or the market price when the opposite books are empty. It is zero without collar.
+func (o *OrderBook) marketCollar(order *Order) (apd.Decimal, error) {
+	var collar apd.Decimal
+	if order.Kind != KindMarket || o.instrument.MarketPolicy != MarketPolicyCollar {
+		return collar, nil
+	}
+
+	opposite := SideSell
+	if order.IsAsk() {
+		opposite = SideBuy
+	}
+	collar = o.MarketPrice()
+	o.orderMutex.RLock()
+	for iter := o.orders.Iterator(opposite); iter.Valid(); iter.Next() {
+		if best, ok := o.activeOrders[iter.Key().ID]; ok && best.Kind == KindLimit && !best.IsCancelled() {
+			collar = best.Price
+			break
+		}
+	}
+	o.orderMutex.RUnlock()
+	if collar.Sign() <= 0 {
+		return apd.Decimal{}, nil
+	}
+
+	for i := int64(0); i < o.instrument.CollarTicks; i++ {
+		var err error
+		if order.IsBid() {
+			_, err = decimalContext.Add(&collar, &collar, o.instrument.tickSizeAt(&collar))
+		} else {
+			_, err = decimalContext.Sub(&collar, &collar, o.instrument.tickSizeBelow(&collar))
+		}
+		if err != nil {
+			return apd.Decimal{}, err
+		}
+		if collar.Sign() <= 0 { // an ask collar never goes below the lowest tick
+			_, err = decimalContext.Add(&collar, &collar, o.instrument.tickSizeAt(&collar))
+			return collar, err
+		}
+	}
+	return collar, nil
+}
+
+// limitMarketRemainder handles the rest of a market order which found nothing more to match by the market policy
+// of the instrument, it is cancelled or rests as a limit order at the last trade price or at the collar.
+func (o *OrderBook) limitMarketRemainder(order *Order, tracker *OrderTracker, run *matchRun) {
+	if order.Kind != KindMarket || order.IsFilled() || order.IsCancelled() {
+		return
+	}
+
+	var price apd.Decimal
+	switch o.instrument.MarketPolicy {
+	case MarketPolicyRest:
+		return
+	case MarketPolicyCancel:
+		order.Cancel()
+		return
+	case MarketPolicyLimit:
+		price = o.MarketPrice()
+	case MarketPolicyCollar:
+		price = run.collar
+	}
+	if price.Sign() <= 0 { // there is no price to rest at
+		order.Cancel()
+		return
+	}
+
+	order.Kind = KindLimit
+	order.Price = price
+	tracker.Kind = KindLimit
+	tracker.Price = price
+}
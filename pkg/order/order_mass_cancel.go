@@ -0,0 +1,71 @@
+package order
+
+import (
+	"context"
+	"sort"
+)
+
+// CancelFilter selects the orders cancelled by MassCancel, empty fields match every order.
+type CancelFilter struct {
+	CustomerID   string
+	TickerSymbol string
+	Side         Side
+}
+
+// IsEmpty returns true if the filter matches every order.
+func (f CancelFilter) IsEmpty() bool {
+	return f.CustomerID == "" && f.TickerSymbol == "" && f.Side == 0
+}
+
+// Match returns true if the order is selected by the filter.
+func (f CancelFilter) Match(order *Order) bool {
+	return (f.CustomerID == "" || f.CustomerID == order.CustomerID) &&
+		(f.TickerSymbol == "" || f.TickerSymbol == order.TickerSymbol) &&
+		(f.Side == 0 || f.Side == order.Side)
+}
+
+// CancelResult is the outcome of cancelling a single order.
+type CancelResult struct {
+	Order Order
+	Err   error
+}
+
+// MassCancel cancels every resting and stop order selected by the filter at once.
+// Orders of an OCO group cancelled together with another selected order are reported as cancelled too.
+func (o *OrderBook) MassCancel(ctx context.Context, filter CancelFilter) []CancelResult {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+
+	if filter.TickerSymbol != "" && filter.TickerSymbol != o.TickerSymbol {
+		return nil
+	}
+
+	o.orderMutex.RLock()
+	ids := make([]string, 0)
+	for id, order := range o.activeOrders {
+		order := order
+		if filter.Match(&order) {
+			ids = append(ids, id)
+		}
+	}
+	o.orderMutex.RUnlock()
+	sort.Strings(ids)
+
+	results := make([]CancelResult, 0, len(ids))
+	groups := make(map[string]Order)
+	for _, id := range ids {
+		if order, ok := groups[id]; ok {
+			results = append(results, CancelResult{Order: order})
+			continue
+		}
+
+		order, group, err := o.cancel(ctx, id)
+		for _, sibling := range group {
+			groups[sibling.ID] = sibling
+		}
+		results = append(results, CancelResult{Order: order, Err: err})
+	}
+
+	o.repegOrders(ctx)
+	return results
+}
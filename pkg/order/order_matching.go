@@ -0,0 +1,230 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/igrmk/treemap/v2"
+)
+
+// MatchingAlgorithm allocates an incoming order among the resting orders of a price level
+type MatchingAlgorithm interface {
+	// Allocate splits qty among the resting orders of a price level given in their time priority.
+	// qty never exceeds the total quantity of the level and has to be allocated completely.
+	Allocate(qty int64, level []Resting) []int64
+}
+
+// Resting is a resting order of a price level
+type Resting struct {
+	CustomerID string
+	Qty        int64 // the available quantity, the displayed tranche of an iceberg order
+}
+
+// LMM gives the orders of lead market makers a percentage of every incoming order before the other resting orders
+type LMM struct {
+	Customers []string // the customer IDs of the lead market makers
+	Percent   int64    // the percentage of the incoming order allocated to the lead market makers
+}
+
+func (l LMM) active() bool {
+	return l.Percent > 0 && len(l.Customers) > 0
+}
+
+// allocate gives the orders of the lead market makers up to their percentage of qty in their time priority,
+// returns the rest of qty
+func (l LMM) allocate(qty int64, level []Resting, shares []int64) int64 {
+	if !l.active() {
+		return qty
+	}
+
+	quota := qty * l.Percent / 100
+	for i := range level {
+		if !slices.Contains(l.Customers, level[i].CustomerID) {
+			continue
+		}
+		share := min(quota, level[i].Qty-shares[i])
+		shares[i] += share
+		quota -= share
+		qty -= share
+	}
+	return qty
+}
+
+// allocateInTime allocates qty to the orders in their time priority, returns the rest of qty
+func allocateInTime(qty int64, level []Resting, shares []int64) int64 {
+	for i := range level {
+		share := min(qty, level[i].Qty-shares[i])
+		shares[i] += share
+		qty -= share
+	}
+	return qty
+}
+
+// FIFO allocates in price-time priority, the orders of the lead market makers first when LMM is set
+type FIFO struct {
+	LMM LMM
+}
+
+// Allocate is implemented for MatchingAlgorithm
+func (a FIFO) Allocate(qty int64, level []Resting) []int64 {
+	shares := make([]int64, len(level))
+	qty = a.LMM.allocate(qty, level, shares)
+	allocateInTime(qty, level, shares)
+	return shares
+}
+
+// ProRata allocates in proportion to the quantity of the resting orders of a price level. The shares are rounded
+// down, the rest and the shares below the minimum allocation are allocated in time priority.
+type ProRata struct {
+	TopOrder      bool  // the first order of the price level is filled before the others
+	MinAllocation int64 // shares below are not allocated pro-rata
+	LMM           LMM   // the lead market makers get their percentage after the top order
+}
+
+// Allocate is implemented for MatchingAlgorithm
+func (a ProRata) Allocate(qty int64, level []Resting) []int64 {
+	shares := make([]int64, len(level))
+	if a.TopOrder && len(level) > 0 {
+		shares[0] = min(qty, level[0].Qty)
+		qty -= shares[0]
+	}
+	qty = a.LMM.allocate(qty, level, shares)
+
+	var total int64
+	for i := range level {
+		total += level[i].Qty - shares[i]
+	}
+	if qty > 0 && total > 0 {
+		rest := qty
+		for i := range level {
+			share := qty * (level[i].Qty - shares[i]) / total
+			if share == 0 || share < a.MinAllocation {
+				continue
+			}
+			shares[i] += share
+			rest -= share
+		}
+		qty = rest
+	}
+
+	allocateInTime(qty, level, shares)
+	return shares
+}
+
+// ParseMatchingAlgorithm returns the matching algorithm by its name, an empty name is FIFO
+func ParseMatchingAlgorithm(name string, topOrder bool, minAllocation int64, lmm LMM) (MatchingAlgorithm, error) {
+	if lmm.Percent < 0 || lmm.Percent > 100 || minAllocation < 0 {
+		return nil, fmt.Errorf("lmm percent %d min allocation %d %w", lmm.Percent, minAllocation, ErrInvalidInstrument)
+	}
+
+	switch name {
+	case "", "fifo":
+		return FIFO{LMM: lmm}, nil // the top order is always filled first
+	case "pro-rata":
+		return ProRata{TopOrder: topOrder, MinAllocation: minAllocation, LMM: lmm}, nil
+	default:
+		return nil, fmt.Errorf("matching algorithm %s %w", name, ErrInvalidInstrument)
+	}
+}
+
+// allocatesLevels returns true when the matching algorithm allocates within the price levels,
+// plain FIFO walks the books in price-time priority instead.
+func (o *OrderBook) allocatesLevels() bool {
+	fifo, ok := o.algorithm.(FIFO)
+	return !ok || fifo.LMM.active()
+}
+
+// allocateLevel allocates the incoming order among the resting orders of the price level starting at iter.
+// Self-trades are prevented only with the resting orders which get an allocation, the level is reallocated without
+// them. Returns the allocated quantities by order ID and the IDs of the orders to remove.
+func (o *OrderBook) allocateLevel(ctx context.Context, order *Order, iter treemap.ForwardIterator[OrderTracker, bool], run *matchRun) (map[string]int64, []string, error) {
+	var (
+		removed []string
+		orders  []Order
+	)
+	price := iter.Key().Price
+	for ; iter.Valid(); iter.Next() {
+		if tracker := iter.Key(); tracker.Kind != KindLimit || tracker.Price.Cmp(&price) != 0 {
+			break // the end of the price level
+		}
+		resting, ok := o.findActiveOrder(iter.Key().ID)
+		if !ok || resting.IsCancelled() {
+			continue
+		}
+		orders = append(orders, resting)
+	}
+
+	for {
+		allocations, err := o.allocateOrders(order, orders)
+		if err != nil {
+			return nil, removed, err
+		}
+		selfTrade := -1
+		for i := range orders {
+			if order.STPMode != STPNone && allocations[orders[i].ID] > 0 && isSelfTrade(order, &orders[i]) {
+				selfTrade = i
+				break
+			}
+		}
+		if selfTrade < 0 {
+			return allocations, removed, nil
+		}
+
+		if o.preventSelfTrade(ctx, order, &orders[selfTrade], run) {
+			removed = append(removed, orders[selfTrade].ID)
+		}
+		if order.IsCancelled() {
+			return nil, removed, nil
+		}
+		orders = append(orders[:selfTrade:selfTrade], orders[selfTrade+1:]...)
+	}
+}
+
+// allocateOrders allocates the incoming order among the resting orders of a price level, all-or-nothing orders
+// take part only when the incoming order fills the whole level.
+func (o *OrderBook) allocateOrders(order *Order, orders []Order) (map[string]int64, error) {
+	level := make([]Resting, len(orders))
+	var total int64
+	for i := range orders {
+		available := orders[i].UnfilledQty()
+		if orders[i].IsIceberg() && !orders[i].Params.Is(ConditionAON) {
+			available = orders[i].VisibleQty
+		}
+		level[i] = Resting{CustomerID: orders[i].CustomerID, Qty: available}
+		total += available
+	}
+
+	allocations := make(map[string]int64, len(orders))
+	unfilled := order.UnfilledQty()
+	if order.IsNotional() && len(orders) > 0 {
+		affordable, err := o.notionalQty(order, &orders[0].Price)
+		if err != nil {
+			return nil, err
+		}
+		unfilled = min(unfilled, affordable)
+	}
+	if unfilled >= total { // the whole level is filled
+		for i := range orders {
+			allocations[orders[i].ID] = level[i].Qty
+		}
+		return allocations, nil
+	}
+
+	// all-or-nothing orders can't be filled by a share of the order
+	filtered, filteredLevel := make([]Order, 0, len(orders)), make([]Resting, 0, len(level))
+	total = 0
+	for i := range orders {
+		if orders[i].Params.Is(ConditionAON) {
+			continue
+		}
+		filtered = append(filtered, orders[i])
+		filteredLevel = append(filteredLevel, level[i])
+		total += level[i].Qty
+	}
+	shares := o.algorithm.Allocate(min(unfilled, total), filteredLevel)
+	for i := range filtered {
+		allocations[filtered[i].ID] = shares[i]
+	}
+	return allocations, nil
+}
@@ -0,0 +1,52 @@
+package order
+
+import (
+	"github.com/cockroachdb/apd"
+)
+
+// notionalQty returns the quantity the unused notional of the order buys or sells at the price,
+// rounded down to the lot size
+func (o *OrderBook) notionalQty(order *Order, price *apd.Decimal) (int64, error) {
+	var left, qty apd.Decimal
+	if _, err := decimalContext.Sub(&left, &order.Notional, &order.FilledNotional); err != nil {
+		return 0, err
+	}
+	if left.Sign() <= 0 || price.Sign() <= 0 {
+		return 0, nil
+	}
+	if _, err := decimalContext.QuoInteger(&qty, &left, price); err != nil {
+		return 0, err
+	}
+	n, err := qty.Int64()
+	if err != nil {
+		return 0, err
+	}
+	if lot := o.instrument.LotSize; lot > 1 {
+		n -= n % lot
+	}
+	return n, nil
+}
+
+// finishNotional sets the quantity of the matched notional order to its filled quantity, the notional which can't be
+// used is released. The order is cancelled when nothing was filled.
+func (o *Order) finishNotional() {
+	o.Qty = o.FilledQty
+	if o.FilledQty == 0 {
+		o.Cancel()
+	}
+}
+
+// fillNotional adds the price times the quantity of a trade to the filled notional of both orders, returns the notional
+func fillNotional(price *apd.Decimal, qty int64, order, opposite *Order) (apd.Decimal, error) {
+	var total apd.Decimal
+	if _, err := decimalContext.Mul(&total, price, apd.New(qty, 0)); err != nil {
+		return total, err
+	}
+	if _, err := decimalContext.Add(&order.FilledNotional, &order.FilledNotional, &total); err != nil {
+		return total, err
+	}
+	if _, err := decimalContext.Add(&opposite.FilledNotional, &opposite.FilledNotional, &total); err != nil {
+		return total, err
+	}
+	return total, nil
+}
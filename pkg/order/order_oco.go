@@ -0,0 +1,110 @@
+package order
+
+import "context"
+
+// ocoKey identifies a one-cancels-other group, groups of different customers never interfere
+type ocoKey struct {
+	customerID string
+	groupID    string
+}
+
+func newOCOKey(order *Order) ocoKey {
+	return ocoKey{customerID: order.CustomerID, groupID: order.OCOGroupID}
+}
+
+// clientOrderKey identifies an order by the ID given by its customer
+type clientOrderKey struct {
+	customerID    string
+	clientOrderID string
+}
+
+func newClientOrderKey(order *Order) clientOrderKey {
+	return clientOrderKey{customerID: order.CustomerID, clientOrderID: order.ClientOrderID}
+}
+
+// IsOCO returns true if the order belongs to a one-cancels-other group.
+func (o *Order) IsOCO() bool {
+	return o.OCOGroupID != ""
+}
+
+// joinOCOGroup adds a stored order to its one-cancels-other group, o.orderMutex has to be held.
+// A filled order has already cancelled its group.
+func (o *OrderBook) joinOCOGroup(order *Order) {
+	if !order.IsOCO() || order.FilledQty > 0 {
+		return
+	}
+	key := newOCOKey(order)
+	for _, id := range o.ocoGroups[key] {
+		if id == order.ID {
+			return // a requeued order is stored again
+		}
+	}
+	o.ocoGroups[key] = append(o.ocoGroups[key], order.ID)
+}
+
+// leaveOCOGroup removes an order leaving the books from its one-cancels-other group, o.orderMutex has to be held.
+func (o *OrderBook) leaveOCOGroup(order *Order) {
+	if !order.IsOCO() {
+		return
+	}
+	key := newOCOKey(order)
+	ids := o.ocoGroups[key]
+	for i, id := range ids {
+		if id == order.ID {
+			ids = append(ids[:i], ids[i+1:]...)
+			break
+		}
+	}
+	if len(ids) == 0 {
+		delete(o.ocoGroups, key)
+		return
+	}
+	o.ocoGroups[key] = ids
+}
+
+// cancelOCOGroup cancels the other orders of the order's one-cancels-other group and dissolves the group.
+// The cancelled orders are skipped by matching right away and removed from the books by removeCancelled.
+func (o *OrderBook) cancelOCOGroup(order *Order) []Order {
+	if !order.IsOCO() {
+		return nil
+	}
+
+	o.orderMutex.Lock()
+	defer o.orderMutex.Unlock()
+
+	key := newOCOKey(order)
+	ids := o.ocoGroups[key]
+	delete(o.ocoGroups, key)
+
+	cancelled := make([]Order, 0, len(ids))
+	for _, id := range ids {
+		sibling, ok := o.activeOrders[id]
+		if id == order.ID || !ok || sibling.IsCancelled() {
+			continue
+		}
+		sibling.Cancel()
+		o.activeOrders[id] = sibling
+		cancelled = append(cancelled, sibling)
+	}
+
+	// a leg of a bracket closes the position, the rest of the entry would open a position without protection
+	if _, ok := o.brackets[order.OCOGroupID]; ok {
+		entry, ok := o.activeOrders[order.OCOGroupID]
+		if ok && entry.CustomerID == order.CustomerID && !entry.IsCancelled() {
+			entry.Cancel()
+			o.activeOrders[entry.ID] = entry
+			cancelled = append(cancelled, entry)
+		}
+		delete(o.brackets, order.OCOGroupID)
+	}
+	return cancelled
+}
+
+// removeCancelled removes orders cancelled on behalf of another order from the books and tells why.
+func (o *OrderBook) removeCancelled(ctx context.Context, orders []Order, reason string) {
+	for _, order := range orders {
+		order := order
+		o.removeFromBooks(ctx, order.ID) // might have been removed by matching already
+		o.publishEvent(EventTypeCancelled, &order, reason)
+	}
+}
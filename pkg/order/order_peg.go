@@ -0,0 +1,226 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/apd"
+)
+
+// PegReference is the price of the books a pegged order follows
+type PegReference int8
+
+const (
+	PegNone     PegReference = iota // not a pegged order
+	PegBestBid                      // follows the best bid
+	PegBestAsk                      // follows the best ask
+	PegMidpoint                     // follows the midpoint between the best bid and the best ask
+)
+
+func (p PegReference) String() string {
+	switch p {
+	case PegNone:
+		return "NONE"
+	case PegBestBid:
+		return "BEST_BID"
+	case PegBestAsk:
+		return "BEST_ASK"
+	case PegMidpoint:
+		return "MIDPOINT"
+	default:
+		return "INVALID"
+	}
+}
+
+// IsPegged returns true if the order price follows the books.
+func (o *Order) IsPegged() bool {
+	return o.Peg != PegNone
+}
+
+// HasValidPeg returns true if a pegged order is a limit order (not a stop order) with a non-negative cap.
+func (o *Order) HasValidPeg() bool {
+	if o.Peg < PegNone || o.Peg > PegMidpoint {
+		return false
+	}
+	return o.Kind == KindLimit && !o.Params.Is(ConditionStop) && o.PegCap.Sign() >= 0
+}
+
+// pegReferences returns the best bid and the best ask of the limit orders which are not pegged themselves,
+// so pegged orders never follow each other.
+func (o *OrderBook) pegReferences() (bid, ask *apd.Decimal) {
+	o.orderMutex.RLock()
+	defer o.orderMutex.RUnlock()
+
+	best := func(side Side) *apd.Decimal {
+		for iter := o.orders.Iterator(side); iter.Valid(); iter.Next() {
+			order := o.activeOrders[iter.Key().ID]
+			if order.Kind == KindMarket || order.IsPegged() || order.IsCancelled() {
+				continue
+			}
+			return &order.Price
+		}
+		return nil
+	}
+	return best(SideBuy), best(SideSell)
+}
+
+// pegPrice returns the price the pegged order follows, moved by its offset, rounded to the tick size away from the
+// opposite side and capped by its limit. Returns false if there is no price to follow.
+func (o *OrderBook) pegPrice(order *Order) (apd.Decimal, bool, error) {
+	var price apd.Decimal
+
+	bid, ask := o.pegReferences()
+	switch order.Peg {
+	case PegBestBid:
+		if bid == nil {
+			return price, false, nil
+		}
+		price.Set(bid)
+	case PegBestAsk:
+		if ask == nil {
+			return price, false, nil
+		}
+		price.Set(ask)
+	case PegMidpoint:
+		if bid == nil || ask == nil {
+			return price, false, nil
+		}
+		if _, err := decimalContext.Add(&price, bid, ask); err != nil {
+			return price, false, err
+		}
+		if _, err := decimalContext.Quo(&price, &price, apd.New(2, 0)); err != nil {
+			return price, false, err
+		}
+	default:
+		return price, false, nil
+	}
+
+	if _, err := decimalContext.Add(&price, &price, &order.PegOffset); err != nil {
+		return price, false, err
+	}
+	price, err := o.instrument.roundToTick(&price, order.IsAsk())
+	if err != nil {
+		return price, false, err
+	}
+
+	// never let the order trade through its cap
+	if order.PegCap.Sign() > 0 {
+		if order.IsBid() && price.Cmp(&order.PegCap) > 0 {
+			price.Set(&order.PegCap)
+		}
+		if order.IsAsk() && price.Cmp(&order.PegCap) < 0 {
+			price.Set(&order.PegCap)
+		}
+	}
+	if price.Sign() <= 0 {
+		return price, false, nil
+	}
+	return price, true, nil
+}
+
+// roundToTick rounds the price to a multiple of the tick size, up or down.
+func roundToTick(price, tickSize *apd.Decimal, up bool) (apd.Decimal, error) {
+	var ticks, rounded apd.Decimal
+	if tickSize.Sign() <= 0 {
+		rounded.Set(price)
+		return rounded, nil
+	}
+
+	if _, err := decimalContext.Quo(&ticks, price, tickSize); err != nil {
+		return rounded, err
+	}
+	var err error
+	if up {
+		_, err = decimalContext.Ceil(&ticks, &ticks)
+	} else {
+		_, err = decimalContext.Floor(&ticks, &ticks)
+	}
+	if err != nil {
+		return rounded, err
+	}
+	_, err = decimalContext.Mul(&rounded, &ticks, tickSize)
+	return rounded, err
+}
+
+// repegOrders reprices the pegged orders after the top of the books has changed.
+// A repriced order is submitted again with the time of the reprice, it is matched if it crosses the spread and
+// queues behind the orders already resting at its new price. Orders repriced together keep their previous time order.
+func (o *OrderBook) repegOrders(ctx context.Context) {
+	if !o.matching() { // the orders are repriced once continuous trading starts
+		return
+	}
+	o.orderMutex.RLock()
+	trackers := make([]OrderTracker, 0, len(o.peggedOrders))
+	for id := range o.peggedOrders {
+		if tracker, ok := o.orders.Find(id); ok {
+			trackers = append(trackers, tracker)
+		}
+	}
+	o.orderMutex.RUnlock()
+	sort.Slice(trackers, func(i, j int) bool {
+		return trackers[i].before(trackers[j])
+	})
+
+	// a repriced order can trade and move the prices the others follow, so reprice again until nothing moves
+	for pass := 0; pass <= len(trackers); pass++ {
+		repriced := false
+		for _, tracker := range trackers {
+			order, ok := o.findActiveOrder(tracker.ID)
+			if !ok || order.IsCancelled() {
+				continue
+			}
+			if _, ok := o.orders.Find(tracker.ID); !ok {
+				continue // filled by a previously repriced order
+			}
+
+			price, ok, err := o.pegPrice(&order)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			if !ok || price.Cmp(&order.Price) == 0 {
+				continue // keep the last price when there is nothing to follow
+			}
+			repriced = true
+
+			order.Price = price
+			newTracker := newOrderTracker(order)
+			newTracker.Timestamp = time.Now().UnixNano()
+			newTracker.Sequence = o.nextSequence()
+
+			o.orderMutex.Lock()
+			o.orders.Remove(order.ID)
+			delete(o.activeOrders, order.ID)
+			delete(o.peggedOrders, order.ID)
+			o.orderMutex.Unlock()
+
+			if report, err := o.submit(ctx, order, newTracker); err != nil {
+				if report.Order.ID != "" {
+					order = report.Order // what was matched before the submit failed
+				}
+				o.cancelRepegged(ctx, order, err)
+			}
+		}
+		if !repriced {
+			return
+		}
+	}
+}
+
+// cancelRepegged cancels a pegged order which couldn't be submitted at its new price, so it isn't lost
+// after it left the books. The order is stored as cancelled and the cancel is published.
+func (o *OrderBook) cancelRepegged(ctx context.Context, order Order, err error) {
+	order.Cancel()
+	if _, ok := o.findActiveOrder(order.ID); ok { // stored before the submit failed
+		if err := o.updateActiveOrder(ctx, order); err != nil {
+			log.Println(err)
+		}
+		o.removeFromBooks(ctx, order.ID)
+	} else if err := o.saveOrder(ctx, &order); err != nil {
+		log.Println(err)
+	}
+	o.publishEvent(EventTypeCancelled, &order, fmt.Sprintf("pegged order not repriced %v", err))
+}
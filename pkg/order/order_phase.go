@@ -0,0 +1,236 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+// Phase is the trading phase of the session of an order book
+type Phase int8
+
+const (
+	PhaseContinuous Phase = iota // orders are matched as they arrive, an order book without schedule always trades continuously
+	PhasePreOpen                 // orders are collected without matching before the open
+	PhaseClosing                 // orders are collected without matching before the close
+	PhaseClosed                  // orders are rejected, good-for-day orders are expired
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhaseContinuous:
+		return "CONTINUOUS"
+	case PhasePreOpen:
+		return "PRE_OPEN"
+	case PhaseClosing:
+		return "CLOSING"
+	case PhaseClosed:
+		return "CLOSED"
+	default:
+		return "INVALID"
+	}
+}
+
+// collectsOrders returns true when orders are accepted without matching them
+func (p Phase) collectsOrders() bool {
+	return p == PhasePreOpen || p == PhaseClosing
+}
+
+// Schedule is the time of the trading day, in UTC, each phase starts at.
+// The order book is closed before the pre-open and after the close.
+type Schedule struct {
+	PreOpen    time.Duration
+	Continuous time.Duration
+	Closing    time.Duration
+	Closed     time.Duration
+}
+
+// IsZero returns true when there is no schedule
+func (s Schedule) IsZero() bool {
+	return s == Schedule{}
+}
+
+// IsValid returns true when the phases start in their order within a day
+func (s Schedule) IsValid() bool {
+	return s.PreOpen >= 0 &&
+		s.PreOpen <= s.Continuous &&
+		s.Continuous <= s.Closing &&
+		s.Closing <= s.Closed &&
+		s.Closed < 24*time.Hour
+}
+
+// phaseAt returns the phase at t and the time it changes
+func (s Schedule) phaseAt(t time.Time) (Phase, time.Time) {
+	t = t.UTC()
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	sinceMidnight := t.Sub(midnight)
+	switch {
+	case sinceMidnight < s.PreOpen:
+		return PhaseClosed, midnight.Add(s.PreOpen)
+	case sinceMidnight < s.Continuous:
+		return PhasePreOpen, midnight.Add(s.Continuous)
+	case sinceMidnight < s.Closing:
+		return PhaseContinuous, midnight.Add(s.Closing)
+	case sinceMidnight < s.Closed:
+		return PhaseClosing, midnight.Add(s.Closed)
+	default:
+		return PhaseClosed, midnight.AddDate(0, 0, 1).Add(s.PreOpen)
+	}
+}
+
+// PhaseStatus describes the trading phase of an order book
+type PhaseStatus struct {
+	TickerSymbol string
+	Phase        Phase
+	Halted       bool
+	NextChangeAt time.Time // the scheduled time of the next phase, zero without schedule
+}
+
+// Phase returns the trading phase of the order book
+func (o *OrderBook) Phase() PhaseStatus {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+	return PhaseStatus{
+		TickerSymbol: o.TickerSymbol,
+		Phase:        o.phase,
+		Halted:       o.halt != nil,
+		NextChangeAt: o.nextPhaseAt,
+	}
+}
+
+// SetPhase changes the trading phase of the order book, the schedule changes it again at its next phase
+func (o *OrderBook) SetPhase(ctx context.Context, phase Phase) error {
+	if phase < PhaseContinuous || phase > PhaseClosed {
+		return ErrInvalidPhase
+	}
+
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+	o.changePhase(ctx, phase)
+	return nil
+}
+
+// startSchedule sets the phase of the schedule at now and changes it at the scheduled times
+func (o *OrderBook) startSchedule(now time.Time) {
+	if o.schedule.IsZero() {
+		return
+	}
+
+	phase, next := o.schedule.phaseAt(now)
+	o.phase = phase
+	o.armPhaseTimer(next)
+}
+
+// armPhaseTimer changes the phase at the next scheduled time, o.matchMutex has to be held.
+func (o *OrderBook) armPhaseTimer(next time.Time) {
+	o.nextPhaseAt = next
+	o.phaseTimer = time.AfterFunc(time.Until(next), func() {
+		o.matchMutex.Lock()
+		defer o.matchMutex.Unlock()
+
+		phase, next := o.schedule.phaseAt(time.Now())
+		if phase != o.phase {
+			o.changePhase(context.Background(), phase)
+		}
+		o.armPhaseTimer(next)
+	})
+}
+
+// changePhase moves the order book to the phase, o.matchMutex has to be held.
+// Leaving a phase which collected orders runs its call auction, closing expires the good-for-day orders.
+func (o *OrderBook) changePhase(ctx context.Context, phase Phase) {
+	previous := o.phase
+	if previous == phase {
+		return
+	}
+	o.phase = phase
+	o.publishEvent(EventTypePhaseChanged, nil, fmt.Sprintf("%s -> %s", previous, phase))
+	o.indicative = nil
+
+	if o.halt != nil { // the books are uncrossed once trading resumes
+		return
+	}
+	if previous.collectsOrders() && !phase.collectsOrders() {
+		o.uncross(ctx)
+	}
+	switch phase {
+	case PhaseContinuous:
+		o.open(ctx)
+	case PhaseClosed:
+		o.expireDayOrders(ctx)
+	}
+}
+
+// matching returns true when incoming orders are matched, o.matchMutex has to be held.
+func (o *OrderBook) matching() bool {
+	return o.halt == nil && o.phase == PhaseContinuous
+}
+
+// collecting returns true when orders are accepted without matching them, in the pre-open, the closing
+// or while a halted order book is re-opening. o.matchMutex has to be held.
+func (o *OrderBook) collecting() bool {
+	if o.halt != nil {
+		return o.halt.reopening() && o.phase != PhaseClosed
+	}
+	return o.phase.collectsOrders()
+}
+
+// checkPhase rejects orders the trading phase doesn't accept, o.matchMutex has to be held.
+func (o *OrderBook) checkPhase(order *Order) error {
+	switch {
+	case o.phase == PhaseClosed:
+		return ErrMarketClosed
+	case o.phase.collectsOrders() && (order.Params.Is(ConditionIOC) || order.Params.Is(ConditionFOK) || order.IsNotional()):
+		return fmt.Errorf("phase %s %w", o.phase, ErrNotContinuous)
+	case o.halt.reopening() && (order.Params.Is(ConditionIOC) || order.Params.Is(ConditionFOK) || order.IsNotional()):
+		return fmt.Errorf("re-opening at %s %w", o.halt.resumeAt.UTC().Format(time.RFC3339), ErrNotContinuous)
+	default:
+		return nil
+	}
+}
+
+// rest adds the order to the books without matching it while orders are collected, o.matchMutex has to be held.
+func (o *OrderBook) rest(ctx context.Context, order Order, tracker OrderTracker) (ExecutionReport, error) {
+	o.addToBooks(tracker)
+	if err := o.storeOrder(ctx, order); err != nil {
+		return ExecutionReport{Order: order}, err
+	}
+	o.publishIndicative()
+	return ExecutionReport{Order: order}, nil
+}
+
+// open starts continuous trading, the stop orders crossed by the market price and the pegged orders
+// which moved in the meantime are matched now. o.matchMutex has to be held.
+func (o *OrderBook) open(ctx context.Context) {
+	marketPrice := o.MarketPrice()
+	if marketPrice.Sign() > 0 {
+		o.triggerStopOrders(ctx, marketPrice, marketPrice)
+	}
+	o.repegOrders(ctx)
+}
+
+// uncross runs the auction of the orders collected without matching, o.matchMutex has to be held.
+func (o *OrderBook) uncross(ctx context.Context) {
+	if err := o.auction(ctx); err != nil {
+		log.Println(err)
+	}
+}
+
+// expireDayOrders expires every good-for-day order at the close, o.matchMutex has to be held.
+func (o *OrderBook) expireDayOrders(ctx context.Context) {
+	o.orderMutex.RLock()
+	ids := make([]string, 0)
+	for id, order := range o.activeOrders {
+		if order.Params.Is(ConditionGFD) {
+			ids = append(ids, id)
+		}
+	}
+	o.orderMutex.RUnlock()
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		o.expireOrder(ctx, id)
+	}
+}
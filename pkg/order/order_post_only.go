@@ -0,0 +1,58 @@
+package order
+
+import (
+	"github.com/cockroachdb/apd"
+	"github.com/igrmk/treemap/v2"
+)
+
+// crossedPrice returns the opposite best price the post-only order would cross, ok is false when it doesn't cross.
+// All-or-nothing orders count as well, the order must never rest through the opposite side of the books.
+// The price is zero when the opposite best order is a market order.
+func (o *OrderBook) crossedPrice(order *Order, offers *treemap.TreeMap[OrderTracker, bool]) (apd.Decimal, bool) {
+	for iter := offers.Iterator(); iter.Valid(); iter.Next() {
+		best, ok := o.findActiveOrder(iter.Key().ID)
+		if !ok || best.IsCancelled() {
+			continue
+		}
+		if best.Kind == KindMarket {
+			return apd.Decimal{}, true
+		}
+		if order.IsBid() {
+			return best.Price, order.Price.Cmp(&best.Price) >= 0
+		}
+		return best.Price, order.Price.Cmp(&best.Price) <= 0
+	}
+	return apd.Decimal{}, false
+}
+
+// slidePostOnly reprices a post-only order one tick behind the opposite best price it would cross.
+// The order is rejected unless it asked to be repriced, when there is no price to slide behind
+// or when the new price is outside the price band.
+func (o *OrderBook) slidePostOnly(order *Order, tracker *OrderTracker, oppositeBest *apd.Decimal) error {
+	if !order.PostOnlySlide || oppositeBest.IsZero() {
+		return ErrPostOnlyWouldTake
+	}
+
+	var (
+		price apd.Decimal
+		err   error
+	)
+	if order.IsBid() {
+		_, err = decimalContext.Sub(&price, oppositeBest, o.instrument.tickSizeBelow(oppositeBest))
+	} else {
+		_, err = decimalContext.Add(&price, oppositeBest, o.instrument.tickSizeAt(oppositeBest))
+	}
+	if err != nil {
+		return err
+	}
+	if price.Sign() <= 0 {
+		return ErrPostOnlyWouldTake
+	}
+	if err := o.checkPriceBand(&price); err != nil {
+		return err
+	}
+
+	order.Price = price
+	tracker.Price = price
+	return nil
+}
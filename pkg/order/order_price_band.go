@@ -0,0 +1,124 @@
+package order
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/apd"
+)
+
+// BandPolicy is how the rest of a market order stopped at the price band is handled
+type BandPolicy int8
+
+const (
+	BandPolicyCancel BandPolicy = iota // cancel the rest of the market order
+	BandPolicyLimit                    // rest the market order as a limit order at the band, limit-up/limit-down
+)
+
+func (p BandPolicy) String() string {
+	switch p {
+	case BandPolicyCancel:
+		return "cancel"
+	case BandPolicyLimit:
+		return "limit"
+	default:
+		return "invalid"
+	}
+}
+
+// ParseBandPolicy parses the name of a band policy, an empty name is BandPolicyCancel
+func ParseBandPolicy(name string) (BandPolicy, error) {
+	switch name {
+	case "", BandPolicyCancel.String():
+		return BandPolicyCancel, nil
+	case BandPolicyLimit.String():
+		return BandPolicyLimit, nil
+	default:
+		return BandPolicyCancel, fmt.Errorf("band policy %s %w", name, ErrInvalidInstrument)
+	}
+}
+
+// ReferencePrice returns the price the price band is set around,
+// the reference price set by SetReferencePrice or the market price
+func (o *OrderBook) ReferencePrice() apd.Decimal {
+	o.marketPriceMutex.RLock()
+	defer o.marketPriceMutex.RUnlock()
+	if o.referencePrice.IsZero() {
+		return o.marketPrice
+	}
+	return o.referencePrice
+}
+
+// SetReferencePrice sets the price the price band is set around, e.g. the previous close.
+// The band follows the market price when it is zero.
+func (o *OrderBook) SetReferencePrice(price apd.Decimal) {
+	o.marketPriceMutex.Lock()
+	o.referencePrice = price
+	o.marketPriceMutex.Unlock()
+}
+
+// priceBand returns the lowest and the highest price orders trade at, ok is false when the order book has no band
+func (o *OrderBook) priceBand() (low, high apd.Decimal, ok bool, err error) {
+	percent := &o.instrument.PriceBandPercent
+	reference := o.ReferencePrice()
+	if percent.Sign() <= 0 || reference.Sign() <= 0 {
+		return low, high, false, nil
+	}
+
+	var width apd.Decimal
+	if _, err := decimalContext.Mul(&width, &reference, percent); err != nil {
+		return low, high, false, err
+	}
+	if _, err := decimalContext.Quo(&width, &width, apd.New(100, 0)); err != nil {
+		return low, high, false, err
+	}
+	if _, err := decimalContext.Sub(&low, &reference, &width); err != nil {
+		return low, high, false, err
+	}
+	if _, err := decimalContext.Add(&high, &reference, &width); err != nil {
+		return low, high, false, err
+	}
+	return low, high, true, nil
+}
+
+// checkPriceBand rejects a price outside the price band
+func (o *OrderBook) checkPriceBand(price *apd.Decimal) error {
+	low, high, ok, err := o.priceBand()
+	if err != nil || !ok {
+		return err
+	}
+	if price.Cmp(&low) < 0 || price.Cmp(&high) > 0 {
+		return fmt.Errorf("price %s band %s - %s %w", price, &low, &high, ErrPriceOutsideBand)
+	}
+	return nil
+}
+
+// stopAtPriceBand handles the order which stopped matching at the price band.
+// A market order is cancelled or rests as a limit order at the band by the band policy of the instrument.
+func (o *OrderBook) stopAtPriceBand(order *Order, tracker *OrderTracker, run *matchRun) error {
+	if order.Kind != KindMarket || order.IsFilled() || order.IsCancelled() {
+		o.publishEvent(EventTypePriceBandBreached, order, fmt.Sprintf("matching stopped at the price band %s - %s", &run.bandLow, &run.bandHigh))
+		return nil
+	}
+
+	if o.instrument.PriceBandPolicy != BandPolicyLimit {
+		order.Cancel()
+		o.publishEvent(EventTypePriceBandBreached, order, fmt.Sprintf("market order cancelled at the price band %s - %s", &run.bandLow, &run.bandHigh))
+		return nil
+	}
+
+	// limit-up for bids and limit-down for asks, rounded into the band
+	edge := &run.bandHigh
+	if order.IsAsk() {
+		edge = &run.bandLow
+	}
+	price, err := o.instrument.roundToTick(edge, order.IsAsk())
+	if err != nil {
+		return err
+	}
+	order.Kind = KindLimit
+	order.Price = price
+	tracker.Kind = KindLimit
+	tracker.Price = price
+	o.publishEvent(EventTypePriceBandBreached, order, fmt.Sprintf("market order limited to %s at the price band %s - %s", &price, &run.bandLow, &run.bandHigh))
+	return nil
+}
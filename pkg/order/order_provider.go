@@ -2,6 +2,9 @@ package order
 
 import (
 	"context"
+	"time"
+
+	"github.com/cockroachdb/apd"
 )
 
 // Provider define order service layer
@@ -10,7 +13,33 @@ type Provider interface {
 	Start(ctx context.Context)
 	// SubmitOrder Submit order to order matching engine
 	// Trade history will send by MQ when successful matching
-	SubmitOrder(ctx context.Context, order Order) (err error)
+	// A retry with the same client order id within the idempotency window returns the original report
+	SubmitOrder(ctx context.Context, order Order) (report ExecutionReport, err error)
+	// SubmitBracketOrder Submit an entry order with children which become active once the entry fills
+	SubmitBracketOrder(ctx context.Context, bracket Bracket) (report ExecutionReport, err error)
+	// CancelOrder Cancel an order and remove it from the order book
+	CancelOrder(ctx context.Context, symbol string, orderID string) (order Order, err error)
+	// ReplaceOrder Amend the quantity or the price of an order, zero values keep the current ones
+	ReplaceOrder(ctx context.Context, symbol string, orderID string, qty int64, price apd.Decimal) (report ExecutionReport, err error)
+	// ResolveClientOrderID Find the id of the customer's order by its client order id
+	ResolveClientOrderID(ctx context.Context, symbol string, customerID string, clientOrderID string) (orderID string, err error)
+	// MassCancel Cancel every order matching the filter across all order books
+	MassCancel(ctx context.Context, filter CancelFilter) (results []CancelResult, err error)
+	// SetSelfTradePrevention Set the self-trade prevention of the customer's orders which don't set their own
+	SetSelfTradePrevention(ctx context.Context, customerID string, mode STPMode, groupID string) (err error)
+	// HaltTrading Halt trading in the order book, new orders are rejected and cancels are still accepted
+	HaltTrading(ctx context.Context, symbol string, reason string) (err error)
+	// ResumeTrading Resume trading in the halted order book straight away or after the re-opening period
+	ResumeTrading(ctx context.Context, symbol string, reopenAfter time.Duration) (status TradingStatus, err error)
+	// TradingPhases List the trading phase of the order book, or of every order book when the symbol is empty
+	TradingPhases(ctx context.Context, symbol string) (phases []PhaseStatus, err error)
+	// OpenSession Open an order entry session of the customer, the customer's orders are cancelled
+	// once all its sessions are closed or stop heartbeating within the timeout
+	OpenSession(ctx context.Context, customerID string, timeout time.Duration) (sessionID string, err error)
+	// Heartbeat Keep the session open for another heartbeat timeout
+	Heartbeat(ctx context.Context, sessionID string) (err error)
+	// CloseSession Close the session, e.g. when the client disconnected
+	CloseSession(ctx context.Context, sessionID string) (err error)
 	// ListAllAsks ist all asks orders. include Limit and Market orders
 	ListAllAsks(ctx context.Context, symbol string) (orders []Order, err error)
 	// ListAllBids List all bids orders include Limit and Market orders
@@ -0,0 +1,122 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cockroachdb/apd"
+)
+
+// Replace amends the quantity and the price of an order, a zero quantity or price keeps the current one.
+// Reducing the quantity keeps the time priority of the order, a price change or a quantity increase loses it.
+// The new quantity has to be bigger than the quantity which is already filled. A post-only order which would take
+// liquidity at its new price is cancelled unless it slides.
+func (o *OrderBook) Replace(ctx context.Context, id string, qty int64, price apd.Decimal) (ExecutionReport, error) {
+	o.matchMutex.Lock()
+	defer o.matchMutex.Unlock()
+
+	order, ok := o.findActiveOrder(id)
+	if !ok {
+		order, err := o.findFinishedOrder(ctx, id)
+		return ExecutionReport{Order: order}, err
+	}
+
+	if qty == 0 {
+		qty = order.Qty
+	}
+	if qty <= order.FilledQty { // an amend never fills the order
+		return ExecutionReport{Order: order}, ErrInvalidQty
+	}
+	if order.DisplayQty > qty-order.FilledQty {
+		return ExecutionReport{Order: order}, ErrInvalidDisplayQty
+	}
+	priceChanged := !price.IsZero() && price.Cmp(&order.Price) != 0
+	if priceChanged && order.Kind == KindMarket {
+		return ExecutionReport{Order: order}, ErrInvalidMarketPrice
+	}
+	if priceChanged && order.IsPegged() {
+		return ExecutionReport{Order: order}, ErrInvalidPeg
+	}
+	if err := o.instrument.validateQty(qty); err != nil {
+		return ExecutionReport{Order: order}, err
+	}
+	if priceChanged {
+		if err := o.instrument.validateTick(&price); err != nil {
+			return ExecutionReport{Order: order}, err
+		}
+		if err := o.checkPriceBand(&price); err != nil {
+			return ExecutionReport{Order: order}, err
+		}
+	}
+	if order.Kind == KindLimit {
+		newPrice := order.Price
+		if priceChanged {
+			newPrice = price
+		}
+		if err := o.instrument.validateNotional(&newPrice, qty); err != nil {
+			return ExecutionReport{Order: order}, err
+		}
+	}
+
+	requeue := priceChanged || qty > order.Qty
+	if requeue { // a requeued order is matched again, only reductions are accepted while trading is halted or closed
+		if err := o.checkHalted(); err != nil {
+			return ExecutionReport{Order: order}, err
+		}
+		if o.phase == PhaseClosed {
+			return ExecutionReport{Order: order}, ErrMarketClosed
+		}
+	}
+	order.Qty = qty
+	if priceChanged {
+		order.Price = price
+	}
+	if order.IsIceberg() {
+		order.VisibleQty = min(order.VisibleQty, order.UnfilledQty())
+		if requeue {
+			order.Replenish()
+		}
+	}
+
+	// stop orders are keyed by their stop price, an amend doesn't move them
+	if _, ok := o.stopOrders.Find(id); ok || !requeue {
+		if err := o.updateActiveOrder(ctx, order); err != nil {
+			return ExecutionReport{Order: order}, err
+		}
+		o.publishIndicative()
+		return ExecutionReport{Order: order}, nil
+	}
+
+	tracker := newOrderTracker(order)
+	tracker.Timestamp = time.Now().UnixNano()
+	tracker.Sequence = o.nextSequence()
+
+	o.orderMutex.Lock()
+	o.orders.Remove(id)
+	delete(o.activeOrders, id)
+	delete(o.peggedOrders, id)
+	o.orderMutex.Unlock()
+
+	if !o.matching() { // orders are collected without matching
+		report, err := o.rest(ctx, order, tracker)
+		report.Requeued = true
+		return report, err
+	}
+
+	report, err := o.submit(ctx, order, tracker)
+	report.Requeued = true
+	if errors.Is(err, ErrPostOnlyWouldTake) {
+		order.Cancel()
+		report.Order = order
+		if err := o.saveOrder(ctx, &order); err != nil { // store the order (not in the books)
+			return report, err
+		}
+		o.removeCancelled(ctx, o.cancelOCOGroup(&order), "OCO")
+	}
+	if err != nil {
+		return report, err
+	}
+	o.repegOrders(ctx)
+	return report, nil
+}
@@ -3,6 +3,7 @@ package order
 import (
 	"sort"
 
+	"github.com/cockroachdb/apd"
 	"github.com/igrmk/treemap/v2"
 )
 
@@ -40,11 +41,11 @@ func newComparator(priceDescending bool) Comparator {
 		} else if a.Kind != KindMarket && b.Kind == KindMarket {
 			return false
 		} else if a.Kind == KindMarket && b.Kind == KindMarket {
-			return a.Timestamp < b.Timestamp // if both market order by time
+			return a.before(b) // if both market order by time
 		}
-		priceCmp := a.Price - b.Price // compare prices
-		if priceCmp == 0 {            // if prices are equal, compare timestamps
-			return a.Timestamp < b.Timestamp
+		priceCmp := a.Price.Cmp(&b.Price) // compare prices exactly, 20.1 and 20.10 are the same level
+		if priceCmp == 0 {                // if prices are equal, compare the arrival
+			return a.before(b)
 		}
 		if priceCmp < 0 { // if a price is less than b return true if ascending, false if descending
 			return sort
@@ -63,9 +64,9 @@ func newStopComparator(priceDescending bool) Comparator {
 		sort = descending
 	}
 	return func(x, y OrderTracker) bool { // ignores order types because we're always comparing stop prices
-		priceCmp := x.Price - y.Price // compare prices
-		if priceCmp == 0 {            // if prices are equal, compare timestamps
-			return x.Timestamp < y.Timestamp
+		priceCmp := x.Price.Cmp(&y.Price) // compare prices
+		if priceCmp == 0 {                // if prices are equal, compare the arrival
+			return x.before(y)
 		}
 		if priceCmp < 0 { // if a price is less than b return true if ascending, false if descending
 			return sort
@@ -118,12 +119,12 @@ func (set *Set) Len(side Side) int {
 }
 
 // FindAllAsksAbove ask orders below or equal the price, sorted by time ast
-func (set *Set) FindAllAsksAbove(price float64) []OrderTracker {
+func (set *Set) FindAllAsksAbove(price *apd.Decimal) []OrderTracker {
 	results := make([]OrderTracker, 0)
 
 	for iter := set.Asks.Iterator(); iter.Valid(); iter.Next() {
-		if iter.Key().Price >= price {
-			results = append(results, iter.Key())
+		if tracker := iter.Key(); tracker.Price.Cmp(price) >= 0 {
+			results = append(results, tracker)
 		} else {
 			// iterator returns a sorted array, if price is bigger we don't have to look any further
 			break
@@ -131,19 +132,19 @@ func (set *Set) FindAllAsksAbove(price float64) []OrderTracker {
 	}
 
 	sort.Slice(results, func(i, j int) bool {
-		return results[i].Timestamp < results[j].Timestamp
+		return results[i].before(results[j])
 	})
 
 	return results
 }
 
 // FindAllBidsBelow bids orders above or equal the price, sorted by time ast
-func (set *Set) FindAllBidsBelow(price float64) []OrderTracker {
+func (set *Set) FindAllBidsBelow(price *apd.Decimal) []OrderTracker {
 	results := make([]OrderTracker, 0)
 
 	for iter := set.Bids.Iterator(); iter.Valid(); iter.Next() {
-		if iter.Key().Price <= price {
-			results = append(results, iter.Key())
+		if tracker := iter.Key(); tracker.Price.Cmp(price) <= 0 {
+			results = append(results, tracker)
 		} else {
 			// iterator returns a sorted array, if price is bigger we don't have to look any further
 			break
@@ -151,7 +152,7 @@ func (set *Set) FindAllBidsBelow(price float64) []OrderTracker {
 	}
 
 	sort.Slice(results, func(i, j int) bool {
-		return results[i].Timestamp < results[j].Timestamp
+		return results[i].before(results[j])
 	})
 
 	return results
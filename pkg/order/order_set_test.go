@@ -1,9 +1,11 @@
 package order
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/apd"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,7 +25,7 @@ func TestSet_Add(t *testing.T) {
 				tracker: OrderTracker{
 					ID:        "1",
 					Kind:      KindMarket,
-					Price:     10,
+					Price:     *apd.New(10, 0),
 					Side:      SideSell,
 					Timestamp: time.Now().UnixNano(),
 				},
@@ -36,7 +38,7 @@ func TestSet_Add(t *testing.T) {
 				tracker: OrderTracker{
 					ID:        "1",
 					Kind:      KindMarket,
-					Price:     10,
+					Price:     *apd.New(10, 0),
 					Side:      SideBuy,
 					Timestamp: time.Now().UnixNano(),
 				},
@@ -59,3 +61,33 @@ func TestSet_Add(t *testing.T) {
 		})
 	}
 }
+
+func TestSet_FIFO_Decimal_Prices(t *testing.T) {
+	// 0.1 + 0.2 is 0.30000000000000004 as float64, exactly 0.3 as decimal
+	var sum apd.Decimal
+	_, err := decimalContext.Add(&sum, apd.New(1, -1), apd.New(2, -1))
+	assert.NoError(t, err)
+
+	now := time.Now().UnixNano()
+	prices := []apd.Decimal{*apd.New(3, -1), sum, *apd.New(30, -2), *apd.New(300, -3)}
+	set := NewOrderSet(newComparator(true), newComparator(false))
+	for i := len(prices) - 1; i >= 0; i-- { // added in reverse time priority
+		set.Add(OrderTracker{ID: fmt.Sprint("ask-", i), Kind: KindLimit, Price: prices[i], Side: SideSell, Timestamp: now + int64(i)})
+		set.Add(OrderTracker{ID: fmt.Sprint("bid-", i), Kind: KindLimit, Price: prices[i], Side: SideBuy, Timestamp: now + int64(i)})
+	}
+	set.Add(OrderTracker{ID: "ask-better", Kind: KindLimit, Price: *apd.New(29, -2), Side: SideSell, Timestamp: now + 10})
+	set.Add(OrderTracker{ID: "bid-better", Kind: KindLimit, Price: *apd.New(31, -2), Side: SideBuy, Timestamp: now + 10})
+
+	for _, side := range []Side{SideSell, SideBuy} {
+		prefix := "ask-"
+		if side == SideBuy {
+			prefix = "bid-"
+		}
+		ids := make([]string, 0, set.Len(side))
+		for iter := set.Iterator(side); iter.Valid(); iter.Next() {
+			ids = append(ids, iter.Key().ID)
+		}
+		// the better price first, then the same price level in time priority
+		assert.Equal(t, []string{prefix + "better", prefix + "0", prefix + "1", prefix + "2", prefix + "3"}, ids)
+	}
+}
@@ -0,0 +1,91 @@
+package order
+
+import (
+	"context"
+	"log"
+)
+
+// STPMode is the self-trade prevention applied when an incoming order would trade with a resting order
+// of the same customer or the same STP group
+type STPMode int8
+
+const (
+	STPNone               STPMode = iota // orders of the same customer trade with each other
+	STPCancelNewest                      // cancel the incoming order
+	STPCancelOldest                      // cancel the resting order and keep matching the incoming order
+	STPCancelBoth                        // cancel both orders
+	STPDecrementAndCancel                // cancel the smaller order and decrement the bigger one by its quantity
+)
+
+func (m STPMode) String() string {
+	switch m {
+	case STPNone:
+		return "STP_NONE"
+	case STPCancelNewest:
+		return "STP_CANCEL_NEWEST"
+	case STPCancelOldest:
+		return "STP_CANCEL_OLDEST"
+	case STPCancelBoth:
+		return "STP_CANCEL_BOTH"
+	case STPDecrementAndCancel:
+		return "STP_DECREMENT_AND_CANCEL"
+	default:
+		return "STP_INVALID"
+	}
+}
+
+// IsValid returns true for a known self-trade prevention mode.
+func (m STPMode) IsValid() bool {
+	return m >= STPNone && m <= STPDecrementAndCancel
+}
+
+// isSelfTrade returns true if both orders trade for the same customer or for the same STP group.
+func isSelfTrade(order, opposite *Order) bool {
+	if order.CustomerID == opposite.CustomerID {
+		return true
+	}
+	return order.STPGroupID != "" && order.STPGroupID == opposite.STPGroupID
+}
+
+// preventSelfTrade applies the self-trade prevention mode of the incoming order instead of a trade with the opposite
+// order. Returns true if the opposite order was cancelled, matching stops once the incoming order was cancelled.
+func (o *OrderBook) preventSelfTrade(ctx context.Context, order, opposite *Order, run *matchRun) bool {
+	mode := order.STPMode
+	cancelNewest := mode == STPCancelNewest || mode == STPCancelBoth
+	cancelOldest := mode == STPCancelOldest || mode == STPCancelBoth
+
+	if mode == STPDecrementAndCancel {
+		qty, oppositeQty := order.UnfilledQty(), opposite.UnfilledQty()
+		cancelNewest = qty <= oppositeQty
+		cancelOldest = oppositeQty <= qty
+		if !cancelNewest {
+			order.Qty -= oppositeQty
+			o.publishEvent(EventTypeSelfTradePrevented, order, mode.String())
+		}
+		if !cancelOldest {
+			opposite.Qty -= qty
+			if opposite.IsIceberg() {
+				opposite.VisibleQty = min(opposite.VisibleQty, opposite.UnfilledQty())
+			}
+			if err := o.updateActiveOrder(ctx, *opposite); err != nil {
+				log.Println(err)
+			}
+			o.publishEvent(EventTypeSelfTradePrevented, opposite, mode.String())
+		}
+	}
+
+	if cancelOldest {
+		opposite.Cancel()
+		if err := o.updateActiveOrder(ctx, *opposite); err != nil {
+			log.Println(err)
+		}
+		o.finishBracket(ctx, opposite.ID)
+		run.cancelled = append(run.cancelled, o.cancelOCOGroup(opposite)...)
+		o.publishEvent(EventTypeSelfTradePrevented, opposite, mode.String())
+	}
+	if cancelNewest {
+		order.Cancel()
+		o.publishEvent(EventTypeSelfTradePrevented, order, mode.String())
+	}
+	return cancelOldest
+}
@@ -0,0 +1,86 @@
+package order
+
+import (
+	"context"
+	"log"
+
+	"github.com/cockroachdb/apd"
+)
+
+// HasValidTrail returns true if exactly one of the trail amount and the trail percent is set.
+func (o *Order) HasValidTrail() bool {
+	hasAmount := o.TrailAmount.Sign() > 0
+	hasPercent := o.TrailPercent.Sign() > 0
+	return hasAmount != hasPercent
+}
+
+// trailStopPrice returns the stop price trailing the market price, above it for bids and below it for asks.
+// TrailPercent is given in percent, e.g. 5 means the stop price is 5% away from the market price.
+func (o *Order) trailStopPrice(marketPrice *apd.Decimal) (apd.Decimal, error) {
+	var (
+		distance  apd.Decimal
+		stopPrice apd.Decimal
+	)
+
+	if o.TrailAmount.Sign() > 0 {
+		distance.Set(&o.TrailAmount)
+	} else {
+		if _, err := decimalContext.Mul(&distance, marketPrice, &o.TrailPercent); err != nil {
+			return stopPrice, err
+		}
+		if _, err := decimalContext.Quo(&distance, &distance, apd.New(100, 0)); err != nil {
+			return stopPrice, err
+		}
+	}
+
+	var err error
+	if o.IsBid() {
+		_, err = decimalContext.Add(&stopPrice, marketPrice, &distance)
+	} else {
+		_, err = decimalContext.Sub(&stopPrice, marketPrice, &distance)
+	}
+	return stopPrice, err
+}
+
+// trailStopOrders moves trailing stop prices along with the market price and re-sorts them in the stop orders.
+// A stop price only follows a favourable market, up for asks and down for bids, so the orders fire once
+// the market reverses by the trail.
+func (o *OrderBook) trailStopOrders(ctx context.Context, price apd.Decimal) {
+	o.orderMutex.RLock()
+	trackers := make([]OrderTracker, 0, len(o.stopOrders.OrderTrackers))
+	for _, tracker := range o.stopOrders.OrderTrackers {
+		trackers = append(trackers, tracker)
+	}
+	o.orderMutex.RUnlock()
+
+	for _, tracker := range trackers {
+		order, ok := o.findActiveOrder(tracker.ID)
+		if !ok || !order.Params.Is(ConditionTrailingStop) {
+			continue
+		}
+
+		stopPrice, err := order.trailStopPrice(&price)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if order.IsAsk() && stopPrice.Cmp(&order.StopPrice) <= 0 {
+			continue
+		}
+		if order.IsBid() && stopPrice.Cmp(&order.StopPrice) >= 0 {
+			continue
+		}
+
+		order.StopPrice = stopPrice
+		tracker.Price = stopPrice
+
+		o.orderMutex.Lock()
+		o.stopOrders.Remove(tracker.ID)
+		o.stopOrders.Add(tracker)
+		o.orderMutex.Unlock()
+
+		if err := o.updateActiveOrder(ctx, order); err != nil {
+			log.Println(err)
+		}
+	}
+}
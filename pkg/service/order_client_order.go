@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/karta0898098/mome/pkg/order"
+)
+
+const (
+	// DefaultClientOrderIDWindow is how long a client order id is remembered to answer retried submits
+	DefaultClientOrderIDWindow = 10 * time.Minute
+)
+
+// clientOrderKey identifies an order by the id given by its customer
+type clientOrderKey struct {
+	customerID    string
+	clientOrderID string
+}
+
+// clientOrder is a submitted order remembered by its client order id, a retry waits for done and gets the same result
+type clientOrder struct {
+	key       clientOrderKey
+	order     order.Order
+	report    order.ExecutionReport
+	err       error
+	done      chan struct{}
+	expiresAt time.Time
+}
+
+// clientOrders keeps the orders submitted within the idempotency window
+type clientOrders struct {
+	mutex  sync.Mutex
+	window time.Duration
+	orders map[clientOrderKey]*clientOrder
+	queue  []*clientOrder // by submit time, the head leaves the window first
+}
+
+func newClientOrders(window time.Duration) *clientOrders {
+	return &clientOrders{
+		window: window,
+		orders: make(map[clientOrderKey]*clientOrder),
+	}
+}
+
+// begin remembers the order to be submitted, submitted is true when an order with the same
+// client order id was submitted before and the entry holds its result
+func (c *clientOrders) begin(o order.Order, now time.Time) (entry *clientOrder, submitted bool, err error) {
+	key := clientOrderKey{customerID: o.CustomerID, clientOrderID: o.ClientOrderID}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.forget(now)
+	if entry, ok := c.orders[key]; ok {
+		if !sameOrder(entry.order, o) {
+			return nil, false, order.ErrDuplicateClientID
+		}
+		return entry, true, nil
+	}
+
+	entry = &clientOrder{
+		key:       key,
+		order:     o,
+		done:      make(chan struct{}),
+		expiresAt: now.Add(c.window),
+	}
+	c.orders[key] = entry
+	c.queue = append(c.queue, entry)
+	return entry, false, nil
+}
+
+// finish records the result of the submitted order for its retries
+// a rejected order is forgotten, so a later retry is submitted again
+func (c *clientOrders) finish(entry *clientOrder, report order.ExecutionReport, err error) {
+	c.mutex.Lock()
+	entry.report = report
+	entry.err = err
+	if err != nil && c.orders[entry.key] == entry {
+		delete(c.orders, entry.key)
+	}
+	c.mutex.Unlock()
+	close(entry.done)
+}
+
+// find returns the id of the order submitted within the window by its client order id
+func (c *clientOrders) find(customerID, clientOrderID string) (orderID string, symbol string, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.forget(time.Now())
+	entry, ok := c.orders[clientOrderKey{customerID: customerID, clientOrderID: clientOrderID}]
+	if !ok || entry.report.Order.ID == "" {
+		return "", "", false
+	}
+	return entry.report.Order.ID, entry.order.TickerSymbol, true
+}
+
+// forget drops the orders which left the window, the caller holds the mutex
+func (c *clientOrders) forget(now time.Time) {
+	for len(c.queue) > 0 && !c.queue[0].expiresAt.After(now) {
+		entry := c.queue[0]
+		c.queue[0] = nil
+		c.queue = c.queue[1:]
+		if c.orders[entry.key] == entry {
+			delete(c.orders, entry.key)
+		}
+	}
+}
+
+// sameOrder returns true when the retried order asks for the same as the submitted one,
+// every field which changes what is bought or sold, at which price and for how long has to match
+func sameOrder(a, b order.Order) bool {
+	return a.TickerSymbol == b.TickerSymbol &&
+		a.Side == b.Side &&
+		a.Kind == b.Kind &&
+		a.Params == b.Params &&
+		a.Qty == b.Qty &&
+		a.DisplayQty == b.DisplayQty &&
+		a.Notional.Cmp(&b.Notional) == 0 &&
+		a.Price.Cmp(&b.Price) == 0 &&
+		a.StopPrice.Cmp(&b.StopPrice) == 0 &&
+		a.TrailAmount.Cmp(&b.TrailAmount) == 0 &&
+		a.TrailPercent.Cmp(&b.TrailPercent) == 0 &&
+		a.PostOnlySlide == b.PostOnlySlide &&
+		a.Peg == b.Peg &&
+		a.PegOffset.Cmp(&b.PegOffset) == 0 &&
+		a.PegCap.Cmp(&b.PegCap) == 0 &&
+		a.OCOGroupID == b.OCOGroupID &&
+		a.STPMode == b.STPMode &&
+		a.STPGroupID == b.STPGroupID &&
+		a.ExpireAt.Equal(b.ExpireAt)
+}
+
+// submitOnce submits the order once per client order id within the window,
+// a retry gets the result of the original submit marked as duplicate
+func (srv *OrderProviderImpl) submitOnce(ctx context.Context, o order.Order, submit func() (order.ExecutionReport, error)) (report order.ExecutionReport, err error) {
+	if o.ClientOrderID == "" {
+		return submit()
+	}
+
+	entry, submitted, err := srv.clientOrders.begin(o, time.Now())
+	if err != nil {
+		return report, fmt.Errorf("failed to submit order client order id %s %w", o.ClientOrderID, err)
+	}
+	if submitted {
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return report, ctx.Err()
+		}
+
+		log.Ctx(ctx).
+			Info().
+			Str("customer", o.CustomerID).
+			Str("clientOrderID", o.ClientOrderID).
+			Msg("duplicate order submit, reply the original result")
+		report = entry.report
+		report.Duplicate = true
+		return report, entry.err
+	}
+
+	report, err = submit()
+	srv.clientOrders.finish(entry, report, err)
+	return report, err
+}
+
+// ResolveClientOrderID is implemented for order.Provider
+func (srv *OrderProviderImpl) ResolveClientOrderID(ctx context.Context, symbol string, customerID string, clientOrderID string) (orderID string, err error) {
+	orderBook, ok := srv.OrderBooks[symbol]
+	if !ok {
+		return "", fmt.Errorf("failed to resolve client order id ticker symbol %s %w", symbol, order.ErrInvalidTickerSymbol)
+	}
+	if customerID == "" || clientOrderID == "" {
+		return "", fmt.Errorf("failed to resolve client order id customer %s client order id %s %w", customerID, clientOrderID, order.ErrOrderNotFound)
+	}
+
+	if o, ok := orderBook.FindClientOrder(customerID, clientOrderID); ok {
+		return o.ID, nil
+	}
+
+	// the order has left the books, it is still known within the window
+	if orderID, orderSymbol, ok := srv.clientOrders.find(customerID, clientOrderID); ok && orderSymbol == symbol {
+		return orderID, nil
+	}
+	return "", fmt.Errorf("failed to resolve client order id %s %w", clientOrderID, order.ErrOrderNotFound)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/apd"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/karta0898098/mome/pkg/order"
+)
+
+func TestOrderProviderImpl_SubmitOrder_ClientOrderID(t *testing.T) {
+	const symbol = "TEST"
+	ctx := context.Background()
+	book := order.NewOrderBook(symbol, *apd.New(2000, -2), &order.NopRepository{})
+	srv := NewOrderProviderImpl(map[string]*order.OrderBook{symbol: book}, &order.NopRepository{},
+		WithClientOrderIDWindow(50*time.Millisecond))
+
+	newAsk := func(id string) order.Order {
+		return order.Order{
+			ID:            id,
+			TickerSymbol:  symbol,
+			CustomerID:    "c1",
+			ClientOrderID: "x",
+			CreatedAt:     time.Now(),
+			Kind:          order.KindLimit,
+			Qty:           10,
+			Price:         *apd.New(2100, -2),
+			Side:          order.SideSell,
+		}
+	}
+	report, err := srv.SubmitOrder(ctx, newAsk("1"))
+	assert.NoError(t, err)
+	assert.False(t, report.Duplicate)
+
+	// a retry within the window gets the original report
+	report, err = srv.SubmitOrder(ctx, newAsk("2"))
+	assert.NoError(t, err)
+	assert.True(t, report.Duplicate)
+	assert.Equal(t, "1", report.Order.ID)
+	assert.Len(t, book.GetAsks(), 1)
+
+	// a retry asking for something else is rejected
+	mismatches := []func(o *order.Order){
+		func(o *order.Order) { o.Price = *apd.New(2200, -2) },
+		func(o *order.Order) { o.Side = order.SideBuy },
+		func(o *order.Order) { o.Kind = order.KindMarket; o.Price = apd.Decimal{} },
+		func(o *order.Order) { o.Qty = 5 },
+		func(o *order.Order) { o.StopPrice = *apd.New(2050, -2) },
+		func(o *order.Order) { o.Params = order.ConditionIOC },
+		func(o *order.Order) { o.Qty = 0; o.Notional = *apd.New(100, 0) },
+	}
+	for _, mismatch := range mismatches {
+		o := newAsk("3")
+		mismatch(&o)
+		_, err = srv.SubmitOrder(ctx, o)
+		assert.ErrorIs(t, err, order.ErrDuplicateClientID)
+	}
+	assert.Len(t, book.GetAsks(), 1)
+
+	// the client order id can be used again once the order left the books and the window
+	_, err = srv.CancelOrder(ctx, symbol, "1")
+	assert.NoError(t, err)
+	time.Sleep(60 * time.Millisecond)
+	o := newAsk("4")
+	o.Price = *apd.New(2200, -2)
+	report, err = srv.SubmitOrder(ctx, o)
+	assert.NoError(t, err)
+	assert.False(t, report.Duplicate)
+	assert.Equal(t, "4", report.Order.ID)
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/karta0898098/mome/pkg/order"
+)
+
+// HaltTrading is implemented for order.Provider
+func (srv *OrderProviderImpl) HaltTrading(ctx context.Context, symbol string, reason string) (err error) {
+	orderBook, ok := srv.OrderBooks[symbol]
+	if !ok {
+		return fmt.Errorf("failed to halt trading ticker symbol %s %w", symbol, order.ErrInvalidTickerSymbol)
+	}
+
+	orderBook.Halt(ctx, reason)
+
+	log.Ctx(ctx).
+		Warn().
+		Str("symbol", symbol).
+		Str("reason", reason).
+		Msg("halt trading")
+	return nil
+}
+
+// ResumeTrading is implemented for order.Provider
+func (srv *OrderProviderImpl) ResumeTrading(ctx context.Context, symbol string, reopenAfter time.Duration) (status order.TradingStatus, err error) {
+	orderBook, ok := srv.OrderBooks[symbol]
+	if !ok {
+		return status, fmt.Errorf("failed to resume trading ticker symbol %s %w", symbol, order.ErrInvalidTickerSymbol)
+	}
+
+	status, err = orderBook.Resume(ctx, reopenAfter)
+	if err != nil {
+		return status, fmt.Errorf("failed to resume trading %s %w", symbol, err)
+	}
+
+	log.Ctx(ctx).
+		Warn().
+		Str("symbol", symbol).
+		Time("resumeAt", status.ResumeAt).
+		Msg("resume trading")
+	return status, nil
+}
+
+// TradingPhases is implemented for order.Provider
+func (srv *OrderProviderImpl) TradingPhases(ctx context.Context, symbol string) (phases []order.PhaseStatus, err error) {
+	if symbol != "" {
+		orderBook, ok := srv.OrderBooks[symbol]
+		if !ok {
+			return nil, fmt.Errorf("failed to get trading phase ticker symbol %s %w", symbol, order.ErrInvalidTickerSymbol)
+		}
+		return []order.PhaseStatus{orderBook.Phase()}, nil
+	}
+
+	symbols := make([]string, 0, len(srv.OrderBooks))
+	for symbol := range srv.OrderBooks {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	phases = make([]order.PhaseStatus, 0, len(symbols))
+	for _, symbol := range symbols {
+		phases = append(phases, srv.OrderBooks[symbol].Phase())
+	}
+	return phases, nil
+}
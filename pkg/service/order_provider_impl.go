@@ -3,7 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/cockroachdb/apd"
 	"github.com/rs/zerolog/log"
 
 	"github.com/karta0898098/mome/pkg/order"
@@ -20,30 +23,183 @@ var _ order.Provider = &OrderProviderImpl{}
 type OrderProviderImpl struct {
 	OrderBooks map[string]*order.OrderBook
 	OrderRepo  order.Repository
+
+	sessions     *sessions             // order entry sessions, orders of a customer are cancelled when its sessions are gone
+	stp          *selfTradePreventions // self-trade prevention of the customers
+	clientOrders *clientOrders         // orders submitted within the idempotency window by their client order ids
 }
 
 // NewOrderProviderImpl new OrderProviderImpl
-func NewOrderProviderImpl(orderBooks map[string]*order.OrderBook, orderRepo order.Repository) *OrderProviderImpl {
+func NewOrderProviderImpl(orderBooks map[string]*order.OrderBook, orderRepo order.Repository, opts ...ProviderOption) *OrderProviderImpl {
+	options := providerOptions{
+		clientOrderIDWindow: DefaultClientOrderIDWindow,
+	}
+	for _, opt := range opts {
+		opt.apply(&options)
+	}
+
 	return &OrderProviderImpl{
-		OrderBooks: orderBooks,
-		OrderRepo:  orderRepo,
+		OrderBooks:   orderBooks,
+		OrderRepo:    orderRepo,
+		sessions:     newSessions(),
+		stp:          newSelfTradePreventions(),
+		clientOrders: newClientOrders(options.clientOrderIDWindow),
 	}
 }
 
 // Start is implement for Provider
 func (srv *OrderProviderImpl) Start(ctx context.Context) {
+	srv.processEvents(ctx)
 	srv.processTradeEvents(ctx)
 }
 
 // SubmitOrder is implemented for order.Provider
-func (srv *OrderProviderImpl) SubmitOrder(ctx context.Context, o order.Order) (err error) {
+func (srv *OrderProviderImpl) SubmitOrder(ctx context.Context, o order.Order) (report order.ExecutionReport, err error) {
 	orderBook, ok := srv.OrderBooks[o.TickerSymbol]
 	if !ok {
-		return fmt.Errorf("failed to submit order ticker symbol %s %w", o.TickerSymbol, order.ErrInvalidTickerSymbol)
+		return report, fmt.Errorf("failed to submit order ticker symbol %s %w", o.TickerSymbol, order.ErrInvalidTickerSymbol)
+	}
+	if err := validateOrder(o); err != nil {
+		return report, err
+	}
+	srv.applySelfTradePrevention(&o)
+
+	// add order to match algorithm async
+	logger := log.Ctx(ctx)
+	logger.
+		Info().
+		Interface("order", o).
+		Msg("add order to order books")
+	report, err = srv.submitOnce(ctx, o, func() (order.ExecutionReport, error) {
+		return orderBook.Place(ctx, o)
+	})
+	if err != nil {
+		logger.
+			Error().
+			Err(err).
+			Msg("failed to add order to order book")
+		return report, fmt.Errorf("failed to submit order %w", err)
 	}
 
+	return report, nil
+}
+
+// SubmitBracketOrder is implemented for order.Provider
+func (srv *OrderProviderImpl) SubmitBracketOrder(ctx context.Context, bracket order.Bracket) (report order.ExecutionReport, err error) {
+	orderBook, ok := srv.OrderBooks[bracket.Entry.TickerSymbol]
+	if !ok {
+		return report, fmt.Errorf("failed to submit bracket order ticker symbol %s %w", bracket.Entry.TickerSymbol, order.ErrInvalidTickerSymbol)
+	}
+	if err := validateOrder(bracket.Entry); err != nil {
+		return report, err
+	}
+	srv.applySelfTradePrevention(&bracket.Entry)
+	for _, child := range []*order.Order{bracket.TakeProfit, bracket.StopLoss} {
+		if child != nil {
+			srv.applySelfTradePrevention(child)
+		}
+	}
+
+	logger := log.Ctx(ctx)
+	logger.
+		Info().
+		Interface("bracket", bracket).
+		Msg("add bracket order to order books")
+	report, err = srv.submitOnce(ctx, bracket.Entry, func() (order.ExecutionReport, error) {
+		return orderBook.PlaceBracket(ctx, bracket)
+	})
+	if err != nil {
+		logger.
+			Error().
+			Err(err).
+			Msg("failed to add bracket order to order book")
+		return report, fmt.Errorf("failed to submit bracket order %w", err)
+	}
+
+	return report, nil
+}
+
+// CancelOrder is implemented for order.Provider
+func (srv *OrderProviderImpl) CancelOrder(ctx context.Context, symbol string, orderID string) (o order.Order, err error) {
+	orderBook, ok := srv.OrderBooks[symbol]
+	if !ok {
+		return o, fmt.Errorf("failed to cancel order ticker symbol %s %w", symbol, order.ErrInvalidTickerSymbol)
+	}
+
+	o, err = orderBook.Cancel(ctx, orderID)
+	if err != nil {
+		return o, fmt.Errorf("failed to cancel order %s %w", orderID, err)
+	}
+
+	log.Ctx(ctx).
+		Info().
+		Interface("order", o).
+		Msg("cancel order from order books")
+	return o, nil
+}
+
+// ReplaceOrder is implemented for order.Provider
+func (srv *OrderProviderImpl) ReplaceOrder(ctx context.Context, symbol string, orderID string, qty int64, price apd.Decimal) (report order.ExecutionReport, err error) {
+	orderBook, ok := srv.OrderBooks[symbol]
+	if !ok {
+		return report, fmt.Errorf("failed to replace order ticker symbol %s %w", symbol, order.ErrInvalidTickerSymbol)
+	}
+	if qty < 0 {
+		return report, fmt.Errorf("failed to replace order qty %v %w", qty, order.ErrInvalidQty)
+	}
+	if price.Sign() < 0 {
+		return report, fmt.Errorf("failed to replace order %w", order.ErrInvalidLimitPrice)
+	}
+
+	report, err = orderBook.Replace(ctx, orderID, qty, price)
+	if err != nil {
+		return report, fmt.Errorf("failed to replace order %s %w", orderID, err)
+	}
+
+	log.Ctx(ctx).
+		Info().
+		Interface("order", report.Order).
+		Msg("replace order in order books")
+	return report, nil
+}
+
+// MassCancel is implemented for order.Provider
+func (srv *OrderProviderImpl) MassCancel(ctx context.Context, filter order.CancelFilter) (results []order.CancelResult, err error) {
+	if filter.IsEmpty() {
+		return nil, fmt.Errorf("failed to mass cancel %w", order.ErrInvalidCancelFilter)
+	}
+	if filter.TickerSymbol != "" {
+		if _, ok := srv.OrderBooks[filter.TickerSymbol]; !ok {
+			return nil, fmt.Errorf("failed to mass cancel ticker symbol %s %w", filter.TickerSymbol, order.ErrInvalidTickerSymbol)
+		}
+	}
+
+	symbols := make([]string, 0, len(srv.OrderBooks))
+	for symbol := range srv.OrderBooks {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	results = make([]order.CancelResult, 0)
+	for _, symbol := range symbols {
+		results = append(results, srv.OrderBooks[symbol].MassCancel(ctx, filter)...)
+	}
+
+	log.Ctx(ctx).
+		Info().
+		Interface("filter", filter).
+		Msgf("mass cancel %d orders from order books", len(results))
+	return results, nil
+}
+
+// validateOrder checks the order before it is sent to the order book
+func validateOrder(o order.Order) error {
 	// validate order book
-	if o.Qty <= MinQty {
+	if o.IsNotional() { // the quantity follows from the quote amount
+		if o.Qty != 0 || o.Notional.Sign() <= 0 {
+			return fmt.Errorf("failed to submit order qty %v notional %s %w", o.Qty, &o.Notional, order.ErrInvalidNotional)
+		}
+	} else if o.Qty <= MinQty {
 		return fmt.Errorf("failed to submit order qty %v %w", o.Qty, order.ErrInvalidQty)
 	}
 
@@ -51,28 +207,33 @@ func (srv *OrderProviderImpl) SubmitOrder(ctx context.Context, o order.Order) (e
 		return fmt.Errorf("failed to submit order %w", order.ErrInvalidMarketPrice)
 	}
 
-	if o.Kind == order.KindLimit && o.Price.IsZero() {
+	if o.IsPegged() && !o.HasValidPeg() {
+		return fmt.Errorf("failed to submit order peg %v %w", o.Peg, order.ErrInvalidPeg)
+	}
+
+	if o.Kind == order.KindLimit && o.Price.IsZero() && !o.IsPegged() {
 		return fmt.Errorf("failed to submit order %w", order.ErrInvalidLimitPrice)
 	}
 
-	if o.Params.Is(order.ConditionStop) && o.StopPrice.IsZero() {
+	if o.DisplayQty < 0 || o.DisplayQty > o.Qty || (o.DisplayQty > 0 && o.Kind != order.KindLimit) {
+		return fmt.Errorf("failed to submit order display qty %v %w", o.DisplayQty, order.ErrInvalidDisplayQty)
+	}
+
+	if o.Params.Is(order.ConditionTrailingStop) && !o.HasValidTrail() {
+		return fmt.Errorf("failed to submit order %w", order.ErrInvalidTrail)
+	}
+
+	if o.Params.Is(order.ConditionStop) && !o.Params.Is(order.ConditionTrailingStop) && o.StopPrice.IsZero() {
 		return order.ErrInvalidStopPrice
 	}
 
-	// add order to match algorithm async
-	logger := log.Ctx(ctx)
-	logger.
-		Info().
-		Interface("order", o).
-		Msg("add order to order books")
-	_, err = orderBook.Add(ctx, o)
-	if err != nil {
-		logger.
-			Error().
-			Err(err).
-			Msg("failed to add order to order book")
+	if o.Params.Is(order.ConditionPostOnly) && o.Kind != order.KindLimit {
+		return fmt.Errorf("failed to submit order %w", order.ErrInvalidPostOnly)
 	}
 
+	if o.Params.Is(order.ConditionGTD) && !o.ExpireAt.After(time.Now()) {
+		return fmt.Errorf("failed to submit order expire at %v %w", o.ExpireAt, order.ErrInvalidExpireAt)
+	}
 	return nil
 }
 
@@ -111,3 +272,21 @@ LOOP:
 		}
 	}
 }
+
+// processEvents drain order book events like expired orders
+func (srv *OrderProviderImpl) processEvents(ctx context.Context) {
+	for _, book := range srv.OrderBooks {
+		go func(orderBook *order.OrderBook) {
+			for {
+				select {
+				case event := <-orderBook.Events:
+					log.Info().
+						Interface("event", event).
+						Msgf("order book %s event %s", event.TickerSymbol, event.Type)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(book)
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/apd"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/karta0898098/mome/pkg/order"
+)
+
+func TestOrderProviderImpl_SubmitOrder_Notional(t *testing.T) {
+	const symbol = "TEST"
+	ctx := context.Background()
+	books := map[string]*order.OrderBook{
+		symbol: order.NewOrderBook(symbol, *apd.New(2000, -2), &order.NopRepository{}),
+	}
+	srv := NewOrderProviderImpl(books, &order.NopRepository{})
+
+	ask := order.Order{
+		ID:           "ask",
+		TickerSymbol: symbol,
+		CustomerID:   "seller",
+		CreatedAt:    time.Now(),
+		Kind:         order.KindLimit,
+		Qty:          10,
+		Price:        *apd.New(2000, -2),
+		Side:         order.SideSell,
+	}
+	_, err := srv.SubmitOrder(ctx, ask)
+	assert.NoError(t, err)
+
+	// the notional order has no quantity, it buys what 100 affords at 20.00
+	notional := order.Order{
+		ID:           "notional",
+		TickerSymbol: symbol,
+		CustomerID:   "buyer",
+		CreatedAt:    time.Now(),
+		Kind:         order.KindMarket,
+		Notional:     *apd.New(100, 0),
+		Side:         order.SideBuy,
+	}
+	report, err := srv.SubmitOrder(ctx, notional)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), report.Order.FilledQty)
+	assert.Equal(t, 0, report.Order.FilledNotional.Cmp(apd.New(100, 0)))
+
+	// the quote amount replaces the quantity
+	notional.ID = "both"
+	notional.Qty = 5
+	_, err = srv.SubmitOrder(ctx, notional)
+	assert.ErrorIs(t, err, order.ErrInvalidNotional)
+
+	notional.ID = "negative"
+	notional.Qty = 0
+	notional.Notional = *apd.New(-100, 0)
+	_, err = srv.SubmitOrder(ctx, notional)
+	assert.ErrorIs(t, err, order.ErrInvalidNotional)
+}
@@ -0,0 +1,30 @@
+package service
+
+import "time"
+
+// providerOptions are the settings of OrderProviderImpl
+type providerOptions struct {
+	clientOrderIDWindow time.Duration
+}
+
+// A ProviderOption is passed to NewOrderProviderImpl
+type ProviderOption interface {
+	apply(*providerOptions)
+}
+
+// setClientOrderIDWindow for implement provider option pattern
+type setClientOrderIDWindow struct{ window time.Duration }
+
+// apply implement ProviderOption interface
+func (opt *setClientOrderIDWindow) apply(o *providerOptions) {
+	if opt.window > 0 {
+		o.clientOrderIDWindow = opt.window
+	}
+}
+
+// WithClientOrderIDWindow with how long a client order id is remembered, a retried submit within it gets the original result
+func WithClientOrderIDWindow(window time.Duration) ProviderOption {
+	return &setClientOrderIDWindow{
+		window: window,
+	}
+}
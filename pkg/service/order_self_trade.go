@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/karta0898098/mome/pkg/order"
+)
+
+// selfTradePrevention is the self-trade prevention of a customer used by orders which don't set their own
+type selfTradePrevention struct {
+	mode    order.STPMode
+	groupID string
+}
+
+// selfTradePreventions keeps the self-trade prevention of the customers
+type selfTradePreventions struct {
+	mutex     sync.RWMutex
+	customers map[string]selfTradePrevention
+}
+
+func newSelfTradePreventions() *selfTradePreventions {
+	return &selfTradePreventions{
+		customers: make(map[string]selfTradePrevention),
+	}
+}
+
+// SetSelfTradePrevention is implemented for order.Provider
+func (srv *OrderProviderImpl) SetSelfTradePrevention(ctx context.Context, customerID string, mode order.STPMode, groupID string) (err error) {
+	if customerID == "" || !mode.IsValid() {
+		return fmt.Errorf("failed to set self-trade prevention customer %s mode %v %w", customerID, mode, order.ErrInvalidSTPMode)
+	}
+
+	srv.stp.mutex.Lock()
+	if mode == order.STPNone && groupID == "" {
+		delete(srv.stp.customers, customerID)
+	} else {
+		srv.stp.customers[customerID] = selfTradePrevention{mode: mode, groupID: groupID}
+	}
+	srv.stp.mutex.Unlock()
+
+	log.Ctx(ctx).
+		Info().
+		Str("customer", customerID).
+		Str("mode", mode.String()).
+		Str("group", groupID).
+		Msg("set self-trade prevention")
+	return nil
+}
+
+// applySelfTradePrevention sets the self-trade prevention of the customer to an order which doesn't set its own
+func (srv *OrderProviderImpl) applySelfTradePrevention(o *order.Order) {
+	srv.stp.mutex.RLock()
+	stp, ok := srv.stp.customers[o.CustomerID]
+	srv.stp.mutex.RUnlock()
+	if !ok {
+		return
+	}
+
+	if o.STPMode == order.STPNone {
+		o.STPMode = stp.mode
+	}
+	if o.STPGroupID == "" {
+		o.STPGroupID = stp.groupID
+	}
+}
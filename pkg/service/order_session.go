@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/karta0898098/mome/pkg/order"
+)
+
+const (
+	// DefaultHeartbeatTimeout is used when a session doesn't ask for its own heartbeat timeout
+	DefaultHeartbeatTimeout = 10 * time.Second
+)
+
+// session is an order entry session of a customer, its orders are cancelled once the session is gone
+type session struct {
+	id         string
+	customerID string
+	timeout    time.Duration
+	timer      *time.Timer
+}
+
+// sessions keeps the open sessions and counts them per customer
+type sessions struct {
+	mutex     sync.Mutex
+	byID      map[string]*session
+	customers map[string]int
+}
+
+func newSessions() *sessions {
+	return &sessions{
+		byID:      make(map[string]*session),
+		customers: make(map[string]int),
+	}
+}
+
+// OpenSession is implemented for order.Provider
+func (srv *OrderProviderImpl) OpenSession(ctx context.Context, customerID string, timeout time.Duration) (sessionID string, err error) {
+	if customerID == "" || timeout < 0 {
+		return "", fmt.Errorf("failed to open session customer %s timeout %v %w", customerID, timeout, order.ErrInvalidSession)
+	}
+	if timeout == 0 {
+		timeout = DefaultHeartbeatTimeout
+	}
+
+	s := &session{
+		id:         xid.New().String(),
+		customerID: customerID,
+		timeout:    timeout,
+	}
+
+	srv.sessions.mutex.Lock()
+	srv.sessions.byID[s.id] = s
+	srv.sessions.customers[customerID]++
+	s.timer = time.AfterFunc(timeout, func() {
+		srv.closeSession(s.id, "heartbeat timeout")
+	})
+	srv.sessions.mutex.Unlock()
+
+	log.Ctx(ctx).
+		Info().
+		Str("session", s.id).
+		Str("customer", customerID).
+		Msg("open order entry session")
+	return s.id, nil
+}
+
+// Heartbeat is implemented for order.Provider
+func (srv *OrderProviderImpl) Heartbeat(ctx context.Context, sessionID string) (err error) {
+	srv.sessions.mutex.Lock()
+	defer srv.sessions.mutex.Unlock()
+
+	s, ok := srv.sessions.byID[sessionID]
+	if !ok {
+		return fmt.Errorf("failed to heartbeat session %s %w", sessionID, order.ErrSessionNotFound)
+	}
+	s.timer.Reset(s.timeout)
+	return nil
+}
+
+// CloseSession is implemented for order.Provider
+func (srv *OrderProviderImpl) CloseSession(ctx context.Context, sessionID string) (err error) {
+	if !srv.closeSession(sessionID, "session closed") {
+		return fmt.Errorf("failed to close session %s %w", sessionID, order.ErrSessionNotFound)
+	}
+	return nil
+}
+
+// closeSession removes the session and cancels the orders of its customer in every order book,
+// unless the customer has another open session. Returns false if the session was already closed.
+func (srv *OrderProviderImpl) closeSession(sessionID string, reason string) bool {
+	srv.sessions.mutex.Lock()
+	s, ok := srv.sessions.byID[sessionID]
+	if !ok {
+		srv.sessions.mutex.Unlock()
+		return false
+	}
+	s.timer.Stop()
+	delete(srv.sessions.byID, sessionID)
+	srv.sessions.customers[s.customerID]--
+	last := srv.sessions.customers[s.customerID] == 0
+	if last {
+		delete(srv.sessions.customers, s.customerID)
+	}
+	srv.sessions.mutex.Unlock()
+
+	logger := log.With().
+		Str("session", s.id).
+		Str("customer", s.customerID).
+		Str("reason", reason).
+		Logger()
+	if !last {
+		logger.Info().Msg("close order entry session")
+		return true
+	}
+
+	results, err := srv.MassCancel(context.Background(), order.CancelFilter{CustomerID: s.customerID})
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to cancel orders of closed session")
+		return true
+	}
+	logger.Info().Msgf("close order entry session and cancel %d orders", len(results))
+	return true
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/apd"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/karta0898098/mome/pkg/order"
+)
+
+// newSessionProvider returns a provider of two order books, each with a bid of the customers c1 and c2
+func newSessionProvider(t *testing.T) (*OrderProviderImpl, map[string]*order.OrderBook) {
+	ctx := context.Background()
+	books := map[string]*order.OrderBook{
+		"TEST": order.NewOrderBook("TEST", *apd.New(2000, -2), &order.NopRepository{}),
+		"ABC":  order.NewOrderBook("ABC", *apd.New(1000, -2), &order.NopRepository{}),
+	}
+	srv := NewOrderProviderImpl(books, &order.NopRepository{})
+	for symbol := range books {
+		for _, customerID := range []string{"c1", "c2"} {
+			_, err := srv.SubmitOrder(ctx, order.Order{
+				ID:           symbol + customerID,
+				TickerSymbol: symbol,
+				CustomerID:   customerID,
+				CreatedAt:    time.Now(),
+				Kind:         order.KindLimit,
+				Qty:          10,
+				Price:        *apd.New(900, -2),
+				Side:         order.SideBuy,
+			})
+			assert.NoError(t, err)
+		}
+	}
+	return srv, books
+}
+
+// customerBids returns the customers of the bids of every order book
+func customerBids(books map[string]*order.OrderBook) map[string]int {
+	customers := make(map[string]int)
+	for _, book := range books {
+		for _, bid := range book.GetBids() {
+			customers[bid.CustomerID]++
+		}
+	}
+	return customers
+}
+
+func TestOrderProviderImpl_CloseSession(t *testing.T) {
+	ctx := context.Background()
+	srv, books := newSessionProvider(t)
+
+	first, err := srv.OpenSession(ctx, "c1", time.Minute)
+	assert.NoError(t, err)
+	second, err := srv.OpenSession(ctx, "c1", time.Minute)
+	assert.NoError(t, err)
+
+	// another session of the customer is alive, its orders stay
+	assert.NoError(t, srv.CloseSession(ctx, first))
+	assert.Equal(t, map[string]int{"c1": 2, "c2": 2}, customerBids(books))
+	assert.ErrorIs(t, srv.CloseSession(ctx, first), order.ErrSessionNotFound)
+	assert.ErrorIs(t, srv.Heartbeat(ctx, first), order.ErrSessionNotFound)
+
+	// the last session is closed, the orders of the customer are cancelled in every order book
+	assert.NoError(t, srv.Heartbeat(ctx, second))
+	assert.NoError(t, srv.CloseSession(ctx, second))
+	assert.Equal(t, map[string]int{"c2": 2}, customerBids(books))
+}
+
+func TestOrderProviderImpl_Session_Heartbeat_Timeout(t *testing.T) {
+	ctx := context.Background()
+	srv, books := newSessionProvider(t)
+
+	alive, err := srv.OpenSession(ctx, "c1", time.Minute)
+	assert.NoError(t, err)
+	sessionID, err := srv.OpenSession(ctx, "c1", 50*time.Millisecond)
+	assert.NoError(t, err)
+
+	// the heartbeats keep the session open past its timeout
+	for i := 0; i < 4; i++ {
+		time.Sleep(25 * time.Millisecond)
+		assert.NoError(t, srv.Heartbeat(ctx, sessionID))
+	}
+
+	// the session times out, the orders stay while the other session is alive
+	assert.Eventually(t, func() bool {
+		srv.sessions.mutex.Lock()
+		defer srv.sessions.mutex.Unlock()
+		_, ok := srv.sessions.byID[sessionID]
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+	assert.ErrorIs(t, srv.Heartbeat(ctx, sessionID), order.ErrSessionNotFound)
+	assert.Equal(t, map[string]int{"c1": 2, "c2": 2}, customerBids(books))
+
+	assert.NoError(t, srv.CloseSession(ctx, alive))
+	assert.Equal(t, map[string]int{"c2": 2}, customerBids(books))
+
+	// a single session times out and cancels the orders
+	_, err = srv.OpenSession(ctx, "c2", 50*time.Millisecond)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return len(customerBids(books)) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestOrderProviderImpl_OpenSession_Invalid(t *testing.T) {
+	ctx := context.Background()
+	srv, _ := newSessionProvider(t)
+
+	_, err := srv.OpenSession(ctx, "", time.Minute)
+	assert.ErrorIs(t, err, order.ErrInvalidSession)
+	_, err = srv.OpenSession(ctx, "c1", -time.Minute)
+	assert.ErrorIs(t, err, order.ErrInvalidSession)
+}
@@ -2,9 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"io"
+	"time"
 
 	"github.com/cockroachdb/apd"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/karta0898098/mome/pb/order"
 	"github.com/karta0898098/mome/pkg/order"
@@ -28,6 +34,267 @@ func (h *OrderMatchingHandler) SubmitOrder(ctx context.Context, req *pb.SubmitOr
 	logger := log.Ctx(ctx)
 	logger.Debug().Interface("req", req).Msg("debug...")
 
+	o, err := newOrder(req)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.provider.SubmitOrder(ctx, o)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return newSubmitOrderReply(report), nil
+}
+
+// SubmitBracketOrder is implement for pb.OrderMatchingServiceServer
+func (h *OrderMatchingHandler) SubmitBracketOrder(ctx context.Context, req *pb.SubmitBracketOrderRequest) (*pb.SubmitBracketOrderReply, error) {
+	logger := log.Ctx(ctx)
+	logger.Debug().Interface("req", req).Msg("debug...")
+
+	if req.Entry == nil {
+		return nil, status.Error(codes.InvalidArgument, "entry order is required")
+	}
+	entry, err := newOrder(req.Entry)
+	if err != nil {
+		return nil, err
+	}
+
+	// the children close the position opened by the entry
+	side := order.SideSell
+	if entry.IsAsk() {
+		side = order.SideBuy
+	}
+
+	bracket := order.Bracket{Entry: entry}
+	reply := &pb.SubmitBracketOrderReply{}
+	if req.TakeProfitPrice != nil {
+		price := apd.New(req.TakeProfitPrice.Coefficient, req.TakeProfitPrice.Exponent)
+		takeProfit, err := order.NewOrder(entry.TickerSymbol, entry.CustomerID, order.KindLimit, 0, 0, price, apd.New(0, 0), side)
+		if err != nil {
+			return nil, err
+		}
+		bracket.TakeProfit = &takeProfit
+		reply.TakeProfitOrderID = takeProfit.ID
+	}
+	if req.StopLossStopPrice != nil {
+		kind := order.KindMarket
+		price := apd.New(0, 0)
+		if req.StopLossPrice != nil {
+			kind = order.KindLimit
+			price = apd.New(req.StopLossPrice.Coefficient, req.StopLossPrice.Exponent)
+		}
+		stopPrice := apd.New(req.StopLossStopPrice.Coefficient, req.StopLossStopPrice.Exponent)
+		stopLoss, err := order.NewOrder(entry.TickerSymbol, entry.CustomerID, kind, order.ConditionStop, 0, price, stopPrice, side)
+		if err != nil {
+			return nil, err
+		}
+		bracket.StopLoss = &stopLoss
+		reply.StopLossOrderID = stopLoss.ID
+	}
+
+	report, err := h.provider.SubmitBracketOrder(ctx, bracket)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	reply.Entry = newSubmitOrderReply(report)
+	if report.Duplicate {
+		// the children of this request were not placed, the original ones are unknown here
+		reply.TakeProfitOrderID = ""
+		reply.StopLossOrderID = ""
+	}
+	return reply, nil
+}
+
+// CancelOrder is implement for pb.OrderMatchingServiceServer
+func (h *OrderMatchingHandler) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderReply, error) {
+	orderID, err := h.resolveOrderID(ctx, req.Symbol, req.OrderID, req.CustomerID, req.ClientOrderID)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	o, err := h.provider.CancelOrder(ctx, req.Symbol, orderID)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.CancelOrderReply{
+		OrderID:           o.ID,
+		FilledQuantity:    o.FilledQty,
+		RemainingQuantity: o.UnfilledQty(),
+	}, nil
+}
+
+// ReplaceOrder is implement for pb.OrderMatchingServiceServer
+func (h *OrderMatchingHandler) ReplaceOrder(ctx context.Context, req *pb.ReplaceOrderRequest) (*pb.ReplaceOrderReply, error) {
+	var price apd.Decimal
+	if req.Price != nil {
+		price = *apd.New(req.Price.Coefficient, req.Price.Exponent)
+	}
+
+	orderID, err := h.resolveOrderID(ctx, req.Symbol, req.OrderID, req.CustomerID, req.ClientOrderID)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	report, err := h.provider.ReplaceOrder(ctx, req.Symbol, orderID, req.Quantity, price)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.ReplaceOrderReply{
+		OrderID: report.Order.ID,
+		Price: &pb.Price{
+			Coefficient: report.Order.Price.Coeff.Int64(),
+			Exponent:    report.Order.Price.Exponent,
+		},
+		Quantity:       report.Order.Qty,
+		FilledQuantity: report.Order.FilledQty,
+		Requeued:       report.Requeued,
+	}, nil
+}
+
+// resolveOrderID returns the order id, or finds it by the client order id of the customer when it is empty
+func (h *OrderMatchingHandler) resolveOrderID(ctx context.Context, symbol, orderID, customerID, clientOrderID string) (string, error) {
+	if orderID != "" || clientOrderID == "" {
+		return orderID, nil
+	}
+	return h.provider.ResolveClientOrderID(ctx, symbol, customerID, clientOrderID)
+}
+
+// MassCancel is implement for pb.OrderMatchingServiceServer
+func (h *OrderMatchingHandler) MassCancel(ctx context.Context, req *pb.MassCancelRequest) (*pb.MassCancelReply, error) {
+	results, err := h.provider.MassCancel(ctx, order.CancelFilter{
+		CustomerID:   req.CustomerID,
+		TickerSymbol: req.Symbol,
+		Side:         order.Side(req.Side),
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	reply := &pb.MassCancelReply{
+		Outcomes: make([]*pb.MassCancelOutcome, 0, len(results)),
+	}
+	for _, result := range results {
+		outcome := &pb.MassCancelOutcome{
+			OrderID:           result.Order.ID,
+			Symbol:            result.Order.TickerSymbol,
+			Cancelled:         result.Err == nil,
+			FilledQuantity:    result.Order.FilledQty,
+			RemainingQuantity: result.Order.UnfilledQty(),
+		}
+		if result.Err != nil {
+			outcome.Error = result.Err.Error()
+		}
+		reply.Outcomes = append(reply.Outcomes, outcome)
+	}
+
+	return reply, nil
+}
+
+// SetSelfTradePrevention is implement for pb.OrderMatchingServiceServer
+func (h *OrderMatchingHandler) SetSelfTradePrevention(ctx context.Context, req *pb.SetSelfTradePreventionRequest) (*pb.SetSelfTradePreventionReply, error) {
+	err := h.provider.SetSelfTradePrevention(ctx, req.CustomerID, order.STPMode(req.STP), req.STPGroupID)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.SetSelfTradePreventionReply{}, nil
+}
+
+// HaltTrading is implement for pb.OrderMatchingServiceServer
+func (h *OrderMatchingHandler) HaltTrading(ctx context.Context, req *pb.HaltTradingRequest) (*pb.HaltTradingReply, error) {
+	if err := h.provider.HaltTrading(ctx, req.Symbol, req.Reason); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.HaltTradingReply{}, nil
+}
+
+// ResumeTrading is implement for pb.OrderMatchingServiceServer
+func (h *OrderMatchingHandler) ResumeTrading(ctx context.Context, req *pb.ResumeTradingRequest) (*pb.ResumeTradingReply, error) {
+	reopenAfter := time.Duration(req.ReopenAfterMilli) * time.Millisecond
+	tradingStatus, err := h.provider.ResumeTrading(ctx, req.Symbol, reopenAfter)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	reply := &pb.ResumeTradingReply{Halted: tradingStatus.Halted}
+	if !tradingStatus.ResumeAt.IsZero() {
+		reply.ResumeAtMilli = tradingStatus.ResumeAt.UnixMilli()
+	}
+	return reply, nil
+}
+
+// GetTradingPhase is implement for pb.OrderMatchingServiceServer
+func (h *OrderMatchingHandler) GetTradingPhase(ctx context.Context, req *pb.GetTradingPhaseRequest) (*pb.GetTradingPhaseReply, error) {
+	phases, err := h.provider.TradingPhases(ctx, req.Symbol)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	reply := &pb.GetTradingPhaseReply{
+		Phases: make([]*pb.SymbolTradingPhase, 0, len(phases)),
+	}
+	for _, phase := range phases {
+		symbolPhase := &pb.SymbolTradingPhase{
+			Symbol: phase.TickerSymbol,
+			Phase:  newTradingPhase(phase.Phase),
+			Halted: phase.Halted,
+		}
+		if !phase.NextChangeAt.IsZero() {
+			symbolPhase.NextPhaseAtMilli = phase.NextChangeAt.UnixMilli()
+		}
+		reply.Phases = append(reply.Phases, symbolPhase)
+	}
+	return reply, nil
+}
+
+// Session is implement for pb.OrderMatchingServiceServer
+func (h *OrderMatchingHandler) Session(stream pb.OrderMatchingService_SessionServer) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	timeout := time.Duration(req.HeartbeatTimeoutMilli) * time.Millisecond
+	sessionID, err := h.provider.OpenSession(ctx, req.CustomerID, timeout)
+	if err != nil {
+		return statusError(err)
+	}
+	defer func() {
+		// the stream is gone, cancel the orders unless the session has timed out already
+		_ = h.provider.CloseSession(context.Background(), sessionID)
+	}()
+
+	for {
+		err = stream.Send(&pb.SessionReply{
+			SessionID:      sessionID,
+			TimestampMilli: time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := h.provider.Heartbeat(ctx, sessionID); err != nil {
+			return statusError(err)
+		}
+	}
+}
+
+// newOrder converts the submit order request to an order
+func newOrder(req *pb.SubmitOrderRequest) (order.Order, error) {
 	price := apd.New(0, 0)
 	if req.Price != nil {
 		price = apd.New(req.Price.Coefficient, req.Price.Exponent)
@@ -54,6 +321,10 @@ func (h *OrderMatchingHandler) SubmitOrder(ctx context.Context, req *pb.SubmitOr
 		params |= order.ConditionGFD
 	case pb.OrderParams_ORDER_PARAMS_GTD:
 		params |= order.ConditionGTD
+	case pb.OrderParams_ORDER_PARAMS_TRAILING_STOP:
+		params |= order.ConditionTrailingStop
+	case pb.OrderParams_ORDER_PARAMS_POST_ONLY:
+		params |= order.ConditionPostOnly
 	}
 
 	o, err := order.NewOrder(
@@ -67,18 +338,72 @@ func (h *OrderMatchingHandler) SubmitOrder(ctx context.Context, req *pb.SubmitOr
 		order.Side(req.Side),
 	)
 	if err != nil {
-		return nil, err
+		return o, err
+	}
+	if req.ExpireAtMilli > 0 {
+		o.ExpireAt = time.UnixMilli(req.ExpireAtMilli).UTC()
+	}
+	if req.TrailAmount != nil {
+		o.TrailAmount = *apd.New(req.TrailAmount.Coefficient, req.TrailAmount.Exponent)
+	}
+	if req.TrailPercent != nil {
+		o.TrailPercent = *apd.New(req.TrailPercent.Coefficient, req.TrailPercent.Exponent)
+	}
+	o.DisplayQty = req.DisplayQuantity
+	o.PostOnlySlide = req.PostOnlySlide
+	o.Peg = order.PegReference(req.Peg)
+	o.OCOGroupID = req.OCOGroupID
+	o.STPMode = order.STPMode(req.STP)
+	o.STPGroupID = req.STPGroupID
+	o.ClientOrderID = req.ClientOrderID
+	if req.PegOffset != nil {
+		o.PegOffset = *apd.New(req.PegOffset.Coefficient, req.PegOffset.Exponent)
+	}
+	if req.PegCap != nil {
+		o.PegCap = *apd.New(req.PegCap.Coefficient, req.PegCap.Exponent)
+	}
+	if req.Notional != nil {
+		o.Notional = *apd.New(req.Notional.Coefficient, req.Notional.Exponent)
 	}
 
-	err = h.provider.SubmitOrder(ctx, o)
-	if err != nil {
-		return nil, err
+	return o, nil
+}
+
+// newTradingPhase converts the trading phase of an order book
+func newTradingPhase(phase order.Phase) pb.TradingPhase {
+	switch phase {
+	case order.PhaseContinuous:
+		return pb.TradingPhase_TRADING_PHASE_CONTINUOUS
+	case order.PhasePreOpen:
+		return pb.TradingPhase_TRADING_PHASE_PRE_OPEN
+	case order.PhaseClosing:
+		return pb.TradingPhase_TRADING_PHASE_CLOSING
+	case order.PhaseClosed:
+		return pb.TradingPhase_TRADING_PHASE_CLOSED
+	default:
+		return pb.TradingPhase_TRADING_PHASE_UNSPECIFIED
 	}
+}
 
+// newSubmitOrderReply converts the report of the submitted order to a reply
+// the report of a duplicate submit holds the original order
+func newSubmitOrderReply(report order.ExecutionReport) *pb.SubmitOrderReply {
 	return &pb.SubmitOrderReply{
-		OrderID:        o.ID,
-		CreatedAtMilli: o.CreatedAt.UnixMilli(),
-	}, nil
+		OrderID:        report.Order.ID,
+		CreatedAtMilli: report.Order.CreatedAt.UnixMilli(),
+		Price: &pb.Price{
+			Coefficient: report.Order.Price.Coeff.Int64(),
+			Exponent:    report.Order.Price.Exponent,
+		},
+		FilledQuantity:  report.Order.FilledQty,
+		Repriced:        report.Repriced,
+		Duplicate:       report.Duplicate,
+		MarketRemainder: pb.MarketRemainder(report.MarketRemainder),
+		FilledNotional: &pb.Price{
+			Coefficient: report.Order.FilledNotional.Coeff.Int64(),
+			Exponent:    report.Order.FilledNotional.Exponent,
+		},
+	}
 }
 
 // ListAllAsks is implement for pb.OrderMatchingServiceServer
@@ -110,6 +435,10 @@ func (h *OrderMatchingHandler) ListAllAsks(ctx context.Context, req *pb.ListAllA
 			Quantity:       o.Qty,
 			FilledQuantity: o.FilledQty,
 			Params:         pb.OrderParams(params),
+			ExpireAtMilli:  expireAtMilli(o),
+			Peg:            pb.OrderPeg(o.Peg),
+			OCOGroupID:     o.OCOGroupID,
+			ClientOrderID:  o.ClientOrderID,
 		})
 	}
 
@@ -145,8 +474,110 @@ func (h *OrderMatchingHandler) ListAllBids(ctx context.Context, req *pb.ListAllB
 			Quantity:       o.Qty,
 			FilledQuantity: o.FilledQty,
 			Params:         pb.OrderParams(params),
+			ExpireAtMilli:  expireAtMilli(o),
+			Peg:            pb.OrderPeg(o.Peg),
+			OCOGroupID:     o.OCOGroupID,
+			ClientOrderID:  o.ClientOrderID,
 		})
 	}
 
 	return reply, nil
 }
+
+// expireAtMilli returns the order expire time in milliseconds, zero when the order never expires
+func expireAtMilli(o order.Order) int64 {
+	if o.ExpireAt.IsZero() {
+		return 0
+	}
+	return o.ExpireAt.UnixMilli()
+}
+
+// fieldViolations are the request fields rejected by the order validation errors
+var fieldViolations = []struct {
+	err   error
+	field string
+}{
+	{err: order.ErrInvalidQty, field: "Quantity"},
+	{err: order.ErrInvalidNotional, field: "Notional"},
+	{err: order.ErrInvalidLotSize, field: "Quantity"},
+	{err: order.ErrQtyBelowMin, field: "Quantity"},
+	{err: order.ErrQtyAboveMax, field: "Quantity"},
+	{err: order.ErrNotionalBelowMin, field: "Quantity"},
+	{err: order.ErrNotionalAboveMax, field: "Quantity"},
+	{err: order.ErrInvalidDisplayQty, field: "DisplayQuantity"},
+	{err: order.ErrInvalidTickerSymbol, field: "Symbol"},
+	{err: order.ErrInvalidTickSize, field: "Price"},
+	{err: order.ErrInvalidMarketPrice, field: "Price"},
+	{err: order.ErrInvalidLimitPrice, field: "Price"},
+	{err: order.ErrInvalidStopPrice, field: "StopPrice"},
+	{err: order.ErrInvalidTrail, field: "TrailAmount"},
+	{err: order.ErrInvalidExpireAt, field: "ExpireAtMilli"},
+	{err: order.ErrInvalidPostOnly, field: "Params"},
+	{err: order.ErrInvalidPeg, field: "Peg"},
+	{err: order.ErrInvalidSTPMode, field: "STP"},
+}
+
+// invalidArgument converts an order validation error to an InvalidArgument status
+// with the rejected request field as details
+func invalidArgument(err error) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	for _, violation := range fieldViolations {
+		if !errors.Is(err, violation.err) {
+			continue
+		}
+		detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: violation.field, Description: violation.err.Error()},
+			},
+		})
+		if detailsErr == nil {
+			st = detailed
+		}
+		break
+	}
+	return st.Err()
+}
+
+// statusError converts order errors to gRPC status errors
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, order.ErrOrderNotFound),
+		errors.Is(err, order.ErrSessionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, order.ErrDuplicateClientID):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, order.ErrOrderFinished),
+		errors.Is(err, order.ErrPostOnlyWouldTake),
+		errors.Is(err, order.ErrPriceOutsideBand),
+		errors.Is(err, order.ErrTradingHalted),
+		errors.Is(err, order.ErrNotHalted),
+		errors.Is(err, order.ErrMarketClosed),
+		errors.Is(err, order.ErrNotContinuous),
+		errors.Is(err, order.ErrPegPriceNotFound):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, order.ErrInvalidQty),
+		errors.Is(err, order.ErrInvalidNotional),
+		errors.Is(err, order.ErrInvalidLotSize),
+		errors.Is(err, order.ErrQtyBelowMin),
+		errors.Is(err, order.ErrQtyAboveMax),
+		errors.Is(err, order.ErrNotionalBelowMin),
+		errors.Is(err, order.ErrNotionalAboveMax),
+		errors.Is(err, order.ErrInvalidDisplayQty),
+		errors.Is(err, order.ErrInvalidTickerSymbol),
+		errors.Is(err, order.ErrInvalidTickSize),
+		errors.Is(err, order.ErrInvalidMarketPrice),
+		errors.Is(err, order.ErrInvalidLimitPrice),
+		errors.Is(err, order.ErrInvalidStopPrice),
+		errors.Is(err, order.ErrInvalidTrail),
+		errors.Is(err, order.ErrInvalidExpireAt),
+		errors.Is(err, order.ErrInvalidPostOnly),
+		errors.Is(err, order.ErrInvalidPeg),
+		errors.Is(err, order.ErrInvalidBracket),
+		errors.Is(err, order.ErrInvalidCancelFilter),
+		errors.Is(err, order.ErrInvalidSession),
+		errors.Is(err, order.ErrInvalidSTPMode):
+		return invalidArgument(err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/apd"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/karta0898098/mome/pb/order"
+	"github.com/karta0898098/mome/pkg/order"
+	"github.com/karta0898098/mome/pkg/service"
+)
+
+func TestOrderMatchingHandler_CancelOrder_Finished(t *testing.T) {
+	const symbol = "TEST"
+	ctx := context.Background()
+	books := map[string]*order.OrderBook{
+		symbol: order.NewOrderBook(symbol, *apd.New(2000, -2), &order.NopRepository{}),
+	}
+	srv := service.NewOrderProviderImpl(books, &order.NopRepository{})
+	handler := NewOrderMatchingHandler(srv)
+
+	for _, o := range []order.Order{
+		{ID: "ask", CustomerID: "seller", Kind: order.KindLimit, Qty: 10, Price: *apd.New(2000, -2), Side: order.SideSell},
+		{ID: "bid", CustomerID: "buyer", Kind: order.KindLimit, Qty: 10, Price: *apd.New(2000, -2), Side: order.SideBuy},
+	} {
+		o.TickerSymbol = symbol
+		o.CreatedAt = time.Now()
+		_, err := srv.SubmitOrder(ctx, o)
+		assert.NoError(t, err)
+	}
+
+	// both orders are filled, a cancel of them fails the precondition
+	for _, id := range []string{"ask", "bid"} {
+		_, err := handler.CancelOrder(ctx, &pb.CancelOrderRequest{Symbol: symbol, OrderID: id})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	}
+
+	_, err := handler.CancelOrder(ctx, &pb.CancelOrderRequest{Symbol: symbol, OrderID: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// sessionStream is a session stream of the requests, Recv returns io.EOF once they are received
+type sessionStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*pb.SessionRequest
+	replies  []*pb.SessionReply
+}
+
+func (s *sessionStream) Context() context.Context { return s.ctx }
+
+func (s *sessionStream) Send(reply *pb.SessionReply) error {
+	s.replies = append(s.replies, reply)
+	return nil
+}
+
+func (s *sessionStream) Recv() (*pb.SessionRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func TestOrderMatchingHandler_Session_Cancel_On_Disconnect(t *testing.T) {
+	const symbol = "TEST"
+	ctx := context.Background()
+	book := order.NewOrderBook(symbol, *apd.New(2000, -2), &order.NopRepository{})
+	srv := service.NewOrderProviderImpl(map[string]*order.OrderBook{symbol: book}, &order.NopRepository{})
+	handler := NewOrderMatchingHandler(srv)
+
+	_, err := srv.SubmitOrder(ctx, order.Order{
+		ID:           "bid",
+		TickerSymbol: symbol,
+		CustomerID:   "c1",
+		CreatedAt:    time.Now(),
+		Kind:         order.KindLimit,
+		Qty:          10,
+		Price:        *apd.New(1900, -2),
+		Side:         order.SideBuy,
+	})
+	assert.NoError(t, err)
+
+	// the session is opened, acknowledges a heartbeat and the stream drops
+	stream := &sessionStream{
+		ctx:      ctx,
+		requests: []*pb.SessionRequest{{CustomerID: "c1", HeartbeatTimeoutMilli: 60000}, {}},
+	}
+	assert.NoError(t, handler.Session(stream))
+	assert.Len(t, stream.replies, 2)
+	assert.NotEmpty(t, stream.replies[0].SessionID)
+	assert.Len(t, book.GetBids(), 0)
+
+	// a session without a customer is rejected
+	stream = &sessionStream{ctx: ctx, requests: []*pb.SessionRequest{{}}}
+	assert.Equal(t, codes.InvalidArgument, status.Code(handler.Session(stream)))
+}
+
+func TestOrderMatchingHandler_GetTradingPhase(t *testing.T) {
+	const symbol = "TEST"
+	ctx := context.Background()
+	book := order.NewOrderBook(symbol, *apd.New(2000, -2), &order.NopRepository{})
+	handler := NewOrderMatchingHandler(service.NewOrderProviderImpl(map[string]*order.OrderBook{symbol: book}, &order.NopRepository{}))
+
+	phases := []struct {
+		phase order.Phase
+		want  pb.TradingPhase
+	}{
+		{phase: order.PhaseContinuous, want: pb.TradingPhase_TRADING_PHASE_CONTINUOUS},
+		{phase: order.PhasePreOpen, want: pb.TradingPhase_TRADING_PHASE_PRE_OPEN},
+		{phase: order.PhaseClosing, want: pb.TradingPhase_TRADING_PHASE_CLOSING},
+		{phase: order.PhaseClosed, want: pb.TradingPhase_TRADING_PHASE_CLOSED},
+	}
+	for _, tt := range phases {
+		assert.NoError(t, book.SetPhase(ctx, tt.phase))
+		reply, err := handler.GetTradingPhase(ctx, &pb.GetTradingPhaseRequest{Symbol: symbol})
+		assert.NoError(t, err)
+		if assert.Len(t, reply.Phases, 1) {
+			assert.Equal(t, tt.want, reply.Phases[0].Phase)
+		}
+	}
+}